@@ -34,7 +34,7 @@ func errorUnconfiguredDataSource(req datasource.ConfigureRequest, resp *datasour
 }
 
 func errorDataSourceReadGeneric(resp *datasource.ReadResponse, err error) {
-	resp.Diagnostics.AddError(ErrorGeneric, err.Error())
+	resp.Diagnostics.AddError(apiErrorDiagnostic(ErrorGeneric, err))
 }
 
 func datasourceConfigure(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *KowabungaProviderData {
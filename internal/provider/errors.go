@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+)
+
+const (
+	ErrorApiUnauthorized = "authentication failed, check provider token"
+	ErrorApiForbidden    = "permission denied"
+	ErrorApiNotFound     = "object not found, it may have been deleted outside of Terraform"
+	ErrorApiConflict     = "conflict with an existing object"
+)
+
+var apiErrorReasons = map[int]string{
+	http.StatusUnauthorized: ErrorApiUnauthorized,
+	http.StatusForbidden:    ErrorApiForbidden,
+	http.StatusNotFound:     ErrorApiNotFound,
+	http.StatusConflict:     ErrorApiConflict,
+}
+
+// structured API error body
+type apiErrorBody struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// decodes SDK error into HTTP status code and server message, if any
+func apiErrorDecode(err error) (int, string) {
+	if err == nil {
+		return 0, ""
+	}
+
+	var apiErr *sdk.GenericOpenAPIError
+	if !errors.As(err, &apiErr) {
+		return 0, err.Error()
+	}
+
+	// SDK error string is HTTP status, e.g. "404 Not Found"
+	status, _ := strconv.Atoi(strings.SplitN(apiErr.Error(), " ", 2)[0])
+	message := apiErr.Error()
+
+	body := apiErrorBody{}
+	if json.Unmarshal(apiErr.Body(), &body) == nil {
+		if body.Code != 0 && status == 0 {
+			status = body.Code
+		}
+		if body.Message != "" {
+			message = body.Message
+		}
+	} else if len(apiErr.Body()) > 0 {
+		message = strings.TrimSpace(string(apiErr.Body()))
+	}
+
+	return status, message
+}
+
+// checks whether SDK error is an HTTP 409 conflict
+func apiErrorIsConflict(err error) bool {
+	status, _ := apiErrorDecode(err)
+	return status == http.StatusConflict
+}
+
+// checks whether SDK error is an HTTP 404 not found
+func apiErrorIsNotFound(err error) bool {
+	status, _ := apiErrorDecode(err)
+	return status == http.StatusNotFound
+}
+
+// returns diagnostic summary suffix and detail for SDK error
+func apiErrorDiagnostic(summary string, err error) (string, string) {
+	status, message := apiErrorDecode(err)
+	reason, ok := apiErrorReasons[status]
+	if !ok {
+		return summary, message
+	}
+	return fmt.Sprintf("%s: %s", summary, reason), message
+}
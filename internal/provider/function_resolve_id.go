@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	ResolveIdFunctionName = "resolve_id"
+)
+
+var _ function.Function = &ResolveIdFunction{}
+
+func NewResolveIdFunction(p *KowabungaProvider) func() function.Function {
+	return func() function.Function {
+		return &ResolveIdFunction{provider: p}
+	}
+}
+
+type ResolveIdFunction struct {
+	provider *KowabungaProvider
+}
+
+// supported object kinds, along with their name-or-ID lookup
+var resolveIdKinds = map[string]func(context.Context, *KowabungaProviderData, string) (string, error){
+	KeyHost:     getKaktusID,
+	KeyPool:     getPoolID,
+	KeyProject:  getProjectID,
+	KeyRegion:   getRegionID,
+	KeySubnet:   getSubnetID,
+	KeyTemplate: getTemplateID,
+	KeyVNet:     getVNetID,
+	KeyZone:     getZoneID,
+}
+
+func resolveIdSupportedKinds() []string {
+	return slices.Sorted(maps.Keys(resolveIdKinds))
+}
+
+func (f *ResolveIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = ResolveIdFunctionName
+}
+
+func (f *ResolveIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Resolves a Kowabunga object name into its identifier",
+		MarkdownDescription: "Resolves any Kowabunga object name into its internal identifier, the same way resources parent references do. Requires a configured provider, as lookups are performed against Kowabunga API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                KeyKind,
+				MarkdownDescription: fmt.Sprintf("Kind of object to be resolved, one of: %s", strings.Join(resolveIdSupportedKinds(), ", ")),
+			},
+			function.StringParameter{
+				Name:                KeyName,
+				MarkdownDescription: "Object name (or ID) to be resolved",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ResolveIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kind, name string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &kind, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	resolve, ok := resolveIdKinds[kind]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s: %s (supported ones are %s)", ErrorUnsupportedKind, kind, strings.Join(resolveIdSupportedKinds(), ", ")))
+		return
+	}
+
+	data := f.provider.Data
+	if data == nil {
+		resp.Error = function.NewFuncError(ErrorUnconfiguredProvider)
+		return
+	}
+
+	data.Mutex.Lock()
+	defer data.Mutex.Unlock()
+
+	id, err := resolve(ctx, data, name)
+	if err != nil {
+		summary, detail := apiErrorDiagnostic(ErrorGeneric, err)
+		resp.Error = function.NewFuncError(fmt.Sprintf("%s: %s", summary, detail))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
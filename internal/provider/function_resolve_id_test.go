@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveIdFunctionRunErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		kind string
+	}{
+		{name: "unsupported kind", kind: "kompute"},
+		{name: "unconfigured provider", kind: KeyRegion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewResolveIdFunction(&KowabungaProvider{})()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.kind), types.StringValue("eu-west")}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			f.Run(context.Background(), req, resp)
+
+			if resp.Error == nil {
+				t.Errorf("Run(%q) returned no error", tt.kind)
+			}
+		})
+	}
+}
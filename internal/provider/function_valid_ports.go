@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ValidPortsFunctionName = "valid_ports"
+)
+
+var _ function.Function = &ValidPortsFunction{}
+
+func NewValidPortsFunction() function.Function {
+	return &ValidPortsFunction{}
+}
+
+type ValidPortsFunction struct{}
+
+var validPortsType = map[string]attr.Type{
+	KeyValid: types.BoolType,
+	KeyPorts: types.StringType,
+	KeyError: types.StringType,
+}
+
+func (f *ValidPortsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = ValidPortsFunctionName
+}
+
+func (f *ValidPortsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validates a firewall rule port list",
+		MarkdownDescription: "Validates a port list, as accepted by Kawaii and Kawaii IPsec firewall rules `ports` attributes, e.g. to check computed values before feeding them into rules. Returns an object with `valid` (Boolean) validation status, `ports` (String) normalized port list, with well-known service names expanded (empty if invalid), and `error` (String) validation failure reason (empty if valid).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                KeyPorts,
+				MarkdownDescription: "The port (or list of ports) to be validated. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005). Well-known service names (e.g. ssh, https) are accepted.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: validPortsType,
+		},
+	}
+}
+
+func (f *ValidPortsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ports string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ports))
+	if resp.Error != nil {
+		return
+	}
+
+	result := map[string]attr.Value{
+		KeyValid: types.BoolValue(true),
+		KeyPorts: types.StringValue(networkPortsNormalize(ports)),
+		KeyError: types.StringValue(""),
+	}
+	_, err := networkPortsCheck(ports)
+	if err != nil {
+		result[KeyValid] = types.BoolValue(false)
+		result[KeyPorts] = types.StringValue("")
+		result[KeyError] = types.StringValue(err.Error())
+	}
+
+	object, diags := types.ObjectValue(validPortsType, result)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, object))
+}
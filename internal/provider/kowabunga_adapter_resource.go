@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"slices"
 
 	"github.com/3th1nk/cidr"
 
@@ -11,7 +12,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -55,6 +55,8 @@ type AdapterResourceModel struct {
 	Netmask        types.String   `tfsdk:"netmask"`
 	NetmaskBitSize types.Int64    `tfsdk:"netmask_bitsize"`
 	Gateway        types.String   `tfsdk:"gateway"`
+	Tags           types.List     `tfsdk:"tags"`
+	Metadata       types.Map      `tfsdk:"metadata"`
 }
 
 func (r *AdapterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,11 +64,8 @@ func (r *AdapterResource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *AdapterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
-	resource.ImportStatePassthroughID(ctx, path.Root(KeyCIDR), req, resp)
-	resource.ImportStatePassthroughID(ctx, path.Root(KeyNetmask), req, resp)
-	resource.ImportStatePassthroughID(ctx, path.Root(KeyNetmaskBitSize), req, resp)
-	resource.ImportStatePassthroughID(ctx, path.Root(KeyGateway), req, resp)
+	// network facts are computed from subnet at read time
+	resourceImportStateComposite(ctx, req, resp, KeySubnet)
 }
 
 func (r *AdapterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -78,8 +77,11 @@ func (r *AdapterResource) Schema(ctx context.Context, req resource.SchemaRequest
 		MarkdownDescription: "Manages a network adapter resource",
 		Attributes: map[string]schema.Attribute{
 			KeySubnet: schema.StringAttribute{
-				MarkdownDescription: "Associated subnet name or ID",
+				MarkdownDescription: "Associated subnet name or ID. Changing it forces network adapter re-creation, releasing its former subnet reservation.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyMAC: schema.StringAttribute{
 				MarkdownDescription: "Network adapter hardware MAC address (e.g. 00:11:22:33:44:55). AUto-generated if unspecified.",
@@ -142,6 +144,7 @@ func (r *AdapterResource) Schema(ctx context.Context, req resource.SchemaRequest
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
+	maps.Copy(resp.Schema.Attributes, resourceAttributesTags("adapter"))
 }
 
 // converts adapter from Terraform model to Kowabunga API model
@@ -154,6 +157,8 @@ func adapterResourceToModel(d *AdapterResourceModel) sdk.Adapter {
 		Mac:         d.MAC.ValueStringPointer(),
 		Addresses:   addresses,
 		Reserved:    d.Reserved.ValueBoolPointer(),
+		Tags:        tagsResourceToModel(d.Tags),
+		Metadatas:   metadatasResourceToModel(d.Metadata),
 	}
 }
 
@@ -164,11 +169,7 @@ func adapterModelToResource(r *sdk.Adapter, d *AdapterResourceModel) {
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	if r.Mac != nil {
 		d.MAC = types.StringPointerValue(r.Mac)
 	} else {
@@ -184,6 +185,8 @@ func adapterModelToResource(r *sdk.Adapter, d *AdapterResourceModel) {
 	} else {
 		d.Reserved = types.BoolValue(AdapterDefaultValueReserved)
 	}
+	d.Tags = tagsModelToResource(r.Tags)
+	d.Metadata = metadatasModelToResource(r.Metadatas)
 }
 
 func ipv4MaskString(m []byte) string {
@@ -193,6 +196,22 @@ func ipv4MaskString(m []byte) string {
 	return fmt.Sprintf("%d.%d.%d.%d", m[0], m[1], m[2], m[3])
 }
 
+// finds out the subnet an adapter belongs to, when unknown (e.g. imported from its sole ID)
+func getAdapterSubnetID(ctx context.Context, data *KowabungaProviderData, adapterId string) (string, error) {
+	subnets, _, err := data.K.SubnetAPI.ListSubnets(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+	for _, sn := range subnets {
+		adapters, _, err := data.K.SubnetAPI.ListSubnetAdapters(ctx, sn).Execute()
+		if err == nil && slices.Contains(adapters, adapterId) {
+			return sn, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s", ErrorUnknownSubnet)
+}
+
 func (r *AdapterResource) GetSubnetData(ctx context.Context, data *AdapterResourceModel) error {
 	// find real subnet id if a string was provided
 	subnetId, err := getSubnetID(ctx, r.Data, data.Subnet.ValueString())
@@ -226,7 +245,7 @@ func (r *AdapterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -240,7 +259,7 @@ func (r *AdapterResource) Create(ctx context.Context, req resource.CreateRequest
 	// find parent subnet
 	subnetId, err := getSubnetID(ctx, r.Data, data.Subnet.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, AdapterResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -253,7 +272,7 @@ func (r *AdapterResource) Create(ctx context.Context, req resource.CreateRequest
 
 	adapter, _, err := api.Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, AdapterResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -261,7 +280,7 @@ func (r *AdapterResource) Create(ctx context.Context, req resource.CreateRequest
 	adapterModelToResource(adapter, data) // read back resulting object
 	err = r.GetSubnetData(ctx, data)
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, AdapterResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -276,7 +295,7 @@ func (r *AdapterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -289,14 +308,23 @@ func (r *AdapterResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	adapter, _, err := r.Data.K.AdapterAPI.ReadAdapter(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, AdapterResourceName, data.Name.ValueString())
 		return
 	}
 	adapterModelToResource(adapter, data)
 
+	if data.Subnet.IsNull() {
+		subnetId, err := getAdapterSubnetID(ctx, r.Data, data.ID.ValueString())
+		if err != nil {
+			errorReadGeneric(resp, err, AdapterResourceName, data.Name.ValueString())
+			return
+		}
+		data.Subnet = types.StringValue(subnetId)
+	}
+
 	err = r.GetSubnetData(ctx, data)
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, AdapterResourceName, data.Name.ValueString())
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -309,7 +337,7 @@ func (r *AdapterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -323,7 +351,7 @@ func (r *AdapterResource) Update(ctx context.Context, req resource.UpdateRequest
 	m := adapterResourceToModel(data)
 	_, _, err := r.Data.K.AdapterAPI.UpdateAdapter(ctx, data.ID.ValueString()).Adapter(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, AdapterResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -336,7 +364,7 @@ func (r *AdapterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -347,9 +375,10 @@ func (r *AdapterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
+	// an already vanished adapter is no longer holding any subnet reservation
 	_, err := r.Data.K.AdapterAPI.DeleteAdapter(ctx, data.ID.ValueString()).Execute()
-	if err != nil {
-		errorDeleteGeneric(resp, err)
+	if err != nil && !apiErrorIsNotFound(err) {
+		errorDeleteGeneric(resp, err, AdapterResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
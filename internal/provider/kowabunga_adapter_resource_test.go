@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccAdapterResourceConfig(subnet string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+data "kowabunga_subnet" "test" {
+  name = %q
+}
+
+resource "kowabunga_adapter" "test" {
+  name   = "tf-acc-adapter"
+  subnet = data.kowabunga_subnet.test.id
+}
+`, subnet)
+}
+
+func TestAccAdapterResourceImport(t *testing.T) {
+	resourceName := "kowabunga_adapter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t, testAccEnvSubnet) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdapterResourceConfig(os.Getenv(testAccEnvSubnet)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, KeyCIDR),
+					resource.TestCheckResourceAttrSet(resourceName, KeyNetmask),
+					resource.TestCheckResourceAttrSet(resourceName, KeyNetmaskBitSize),
+					resource.TestCheckResourceAttrSet(resourceName, KeyGateway),
+				),
+			},
+			// import from adapter ID alone, owning subnet being looked up
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// import from <subnet>/<id> composite identifier
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("%s not found in state", resourceName)
+					}
+					return rs.Primary.Attributes[KeySubnet] + ImportIdSeparator + rs.Primary.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+			// imported state results in a clean plan
+			{
+				Config:   testAccAdapterResourceConfig(os.Getenv(testAccEnvSubnet)),
+				PlanOnly: true,
+			},
+		},
+	})
+}
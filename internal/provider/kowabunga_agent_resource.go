@@ -8,8 +8,12 @@ import (
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,10 +21,13 @@ import (
 
 const (
 	AgentResourceName = "agent"
+
+	AgentDefaultValueRegenerateToken = ""
 )
 
 var _ resource.Resource = &AgentResource{}
 var _ resource.ResourceWithImportState = &AgentResource{}
+var _ resource.ResourceWithModifyPlan = &AgentResource{}
 
 func NewAgentResource() resource.Resource {
 	return &AgentResource{}
@@ -36,6 +43,8 @@ type AgentResourceModel struct {
 	Name     types.String   `tfsdk:"name"`
 	Desc     types.String   `tfsdk:"desc"`
 	Type     types.String   `tfsdk:"type"`
+	Token    types.String   `tfsdk:"token"`
+	Regen    types.String   `tfsdk:"regenerate_token"`
 }
 
 func (r *AgentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,11 +70,53 @@ func (r *AgentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					&stringAgentTypeValidator{},
 				},
 			},
+			KeyRegenerateToken: schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value whose change triggers the issuance of a new enrollment token, revoking the previous one (e.g. a timestamp or rotation counter)",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(AgentDefaultValueRegenerateToken),
+			},
+			KeyToken: schema.StringAttribute{
+				MarkdownDescription: "Kowabunga remote agent enrollment token, only available from registration or regeneration time (read-only)",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
+func (r *AgentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *AgentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a new token is to be issued
+	if !plan.Regen.Equal(state.Regen) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(KeyToken), types.StringUnknown())...)
+	}
+}
+
+// issues a new agent authentication token
+func agentSetToken(ctx context.Context, data *KowabungaProviderData, id string) (types.String, error) {
+	token, _, err := data.K.AgentAPI.SetAgentApiToken(ctx, id).Expire(false).Execute()
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringPointerValue(token.Value), nil
+}
+
 // converts agent from Terraform model to Kowabunga API model
 func agentResourceToModel(d *AgentResourceModel) sdk.Agent {
 	return sdk.Agent{
@@ -82,11 +133,7 @@ func agentModelToResource(r *sdk.Agent, d *AgentResourceModel) {
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	d.Type = types.StringValue(r.Type)
 }
 
@@ -96,8 +143,9 @@ func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.Token)
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -111,16 +159,16 @@ func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest,
 	m := agentResourceToModel(data)
 	agent, _, err := r.Data.K.AgentAPI.CreateAgent(ctx).Agent(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, AgentResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(agent.Id)
 	agentModelToResource(agent, data) // read back resulting object
 
 	// create a new authentication token
-	_, _, err = r.Data.K.AgentAPI.SetAgentApiToken(ctx, *agent.Id).Expire(false).Execute()
+	data.Token, err = agentSetToken(ctx, r.Data, *agent.Id)
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, AgentResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -134,7 +182,8 @@ func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	ctx = resourceMaskSensitive(ctx, data.Token)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -147,7 +196,7 @@ func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	agent, _, err := r.Data.K.AgentAPI.ReadAgent(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, AgentResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -156,13 +205,15 @@ func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *AgentResourceModel
+	var data, state *AgentResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, state.Token)
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -175,10 +226,19 @@ func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest,
 	m := agentResourceToModel(data)
 	_, _, err := r.Data.K.AgentAPI.UpdateAgent(ctx, data.ID.ValueString()).Agent(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, AgentResourceName, data.Name.ValueString())
 		return
 	}
 
+	// rotate authentication token
+	if !data.Regen.Equal(state.Regen) {
+		data.Token, err = agentSetToken(ctx, r.Data, data.ID.ValueString())
+		if err != nil {
+			errorUpdateGeneric(resp, err, AgentResourceName, data.Name.ValueString())
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -188,8 +248,9 @@ func (r *AgentResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.Token)
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -202,7 +263,7 @@ func (r *AgentResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	_, err := r.Data.K.AgentAPI.DeleteAgent(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, AgentResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	CurrentUserDataSourceName = "current_user"
+)
+
+var _ datasource.DataSource = &CurrentUserDataSource{}
+var _ datasource.DataSourceWithConfigure = &CurrentUserDataSource{}
+
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &CurrentUserDataSource{}
+}
+
+type CurrentUserDataSource struct {
+	Data *KowabungaProviderData
+}
+
+type CurrentUserDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+}
+
+func (d *CurrentUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	datasourceMetadata(req, resp, CurrentUserDataSourceName)
+}
+
+func (d *CurrentUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Data = datasourceConfigure(req, resp)
+}
+
+func (d *CurrentUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data from the Kowabunga user the provider is authenticated as",
+		Attributes: map[string]schema.Attribute{
+			KeyID: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DataSourceIdDescription,
+			},
+			KeyName: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Authenticated user name",
+			},
+			KeyEmail: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Authenticated user email address",
+			},
+			KeyRole: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Authenticated user role",
+			},
+		},
+	}
+}
+
+func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrentUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.Data.Mutex.Lock()
+	defer d.Data.Mutex.Unlock()
+
+	user, _, err := d.Data.K.UserAPI.ReadUserSelf(ctx).Execute()
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	data.ID = types.StringPointerValue(user.Id)
+	data.Name = types.StringValue(user.Name)
+	data.Email = types.StringValue(user.Email)
+	data.Role = types.StringValue(user.Role)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
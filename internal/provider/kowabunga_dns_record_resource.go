@@ -2,24 +2,42 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
+	"net"
+	"strings"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	DnsRecordResourceName = "dns_record"
+
+	DnsRecordDefaultValueType = DnsRecordTypeA
+	DnsRecordDefaultValueTTL  = 300
+	DnsRecordMinValueTTL      = 1
+	DnsRecordMaxValueTTL      = 604800
 )
 
 var _ resource.Resource = &DnsRecordResource{}
 var _ resource.ResourceWithImportState = &DnsRecordResource{}
+var _ resource.ResourceWithValidateConfig = &DnsRecordResource{}
 
 func NewDnsRecordResource() resource.Resource {
 	return &DnsRecordResource{}
@@ -35,7 +53,10 @@ type DnsRecordResourceModel struct {
 	Name      types.String   `tfsdk:"name"`
 	Desc      types.String   `tfsdk:"desc"`
 	Project   types.String   `tfsdk:"project"`
+	Type      types.String   `tfsdk:"type"`
 	Addresses types.List     `tfsdk:"addresses"`
+	Values    types.List     `tfsdk:"values"`
+	TTL       types.Int64    `tfsdk:"ttl"`
 }
 
 func (r *DnsRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,7 +64,7 @@ func (r *DnsRecordResource) Metadata(ctx context.Context, req resource.MetadataR
 }
 
 func (r *DnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateComposite(ctx, req, resp, KeyProject)
 }
 
 func (r *DnsRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,47 +72,170 @@ func (r *DnsRecordResource) Configure(ctx context.Context, req resource.Configur
 }
 
 func (r *DnsRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	empty, _ := types.ListValue(types.StringType, []attr.Value{})
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DNS record resource",
 		Attributes: map[string]schema.Attribute{
 			KeyProject: schema.StringAttribute{
 				MarkdownDescription: "Associated project name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyType: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The DNS record type. Valid values are `%s`. Default is `%s`", strings.Join(dnsRecordSupportedTypes, " | "), DnsRecordDefaultValueType),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(DnsRecordDefaultValueType),
+				Validators: []validator.String{
+					&stringDnsRecordTypeValidator{},
+				},
 			},
 			KeyAddresses: schema.ListAttribute{
-				MarkdownDescription: "The list of IPv4 addresses to be associated with the DNS record",
+				MarkdownDescription: "The list of IP addresses to be associated with the DNS record (IPv4 for A records, IPv6 for AAAA records)",
 				ElementType:         types.StringType,
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(empty),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(&stringIPAddressValidator{}),
+				},
+			},
+			KeyValues: schema.ListAttribute{
+				MarkdownDescription: "The list of values to be associated with the DNS record (CNAME, TXT and MX records only). CNAME records expect exactly one value",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(empty),
+			},
+			KeyTTL: schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The DNS record time-to-live, in seconds (between %d and %d). Default is `%d`", DnsRecordMinValueTTL, DnsRecordMaxValueTTL, DnsRecordDefaultValueTTL),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(DnsRecordDefaultValueTTL),
+				Validators: []validator.Int64{
+					int64validator.Between(DnsRecordMinValueTTL, DnsRecordMaxValueTTL),
+				},
 			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
+func (r *DnsRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DnsRecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() || data.Addresses.IsUnknown() || data.Values.IsUnknown() {
+		return
+	}
+
+	recordType := DnsRecordDefaultValueType
+	if !data.Type.IsNull() {
+		recordType = data.Type.ValueString()
+	}
+	addresses := len(data.Addresses.Elements())
+	values := len(data.Values.Elements())
+
+	switch recordType {
+	case DnsRecordTypeA, DnsRecordTypeAAAA:
+		if addresses == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(KeyAddresses), ErrorInvalidDnsRecord,
+				fmt.Sprintf("%s: %s records require at least one address", ErrorInvalidDnsRecord, recordType))
+		}
+		if values != 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(KeyValues), ErrorInvalidDnsRecord,
+				fmt.Sprintf("%s: %s records only support addresses", ErrorInvalidDnsRecord, recordType))
+		}
+		dnsRecordValidateAddresses(ctx, &data, recordType, resp)
+	case DnsRecordTypeCNAME, DnsRecordTypeTXT, DnsRecordTypeMX:
+		if addresses != 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(KeyAddresses), ErrorInvalidDnsRecord,
+				fmt.Sprintf("%s: %s records only support values", ErrorInvalidDnsRecord, recordType))
+		}
+		if recordType == DnsRecordTypeCNAME && values != 1 {
+			resp.Diagnostics.AddAttributeError(path.Root(KeyValues), ErrorInvalidDnsRecord,
+				fmt.Sprintf("%s: %s records require exactly one value, got %d", ErrorInvalidDnsRecord, recordType, values))
+		} else if values == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(KeyValues), ErrorInvalidDnsRecord,
+				fmt.Sprintf("%s: %s records require at least one value", ErrorInvalidDnsRecord, recordType))
+		}
+	}
+}
+
+// checks that addresses match record IP family
+func dnsRecordValidateAddresses(ctx context.Context, d *DnsRecordResourceModel, recordType string, resp *resource.ValidateConfigResponse) {
+	addresses := []types.String{}
+	resp.Diagnostics.Append(d.Addresses.ElementsAs(ctx, &addresses, false)...)
+	for i, a := range addresses {
+		if a.IsUnknown() || a.IsNull() {
+			continue
+		}
+		ip := net.ParseIP(a.ValueString())
+		if ip == nil {
+			// already reported by element validator
+			continue
+		}
+		isIPv4 := ip.To4() != nil
+		if recordType == DnsRecordTypeA && !isIPv4 {
+			resp.Diagnostics.AddAttributeError(path.Root(KeyAddresses).AtListIndex(i), ErrorInvalidDnsRecord,
+				fmt.Sprintf("%s: %s records require IPv4 addresses, got %s", ErrorInvalidDnsRecord, recordType, a.ValueString()))
+		}
+		if recordType == DnsRecordTypeAAAA && isIPv4 {
+			resp.Diagnostics.AddAttributeError(path.Root(KeyAddresses).AtListIndex(i), ErrorInvalidDnsRecord,
+				fmt.Sprintf("%s: %s records require IPv6 addresses, got %s", ErrorInvalidDnsRecord, recordType, a.ValueString()))
+		}
+	}
+}
+
 // converts record from Terraform model to Kowabunga API model
-func recordResourceToModel(d *DnsRecordResourceModel) sdk.DnsRecord {
+func recordResourceToModel(ctx *context.Context, d *DnsRecordResourceModel) sdk.DnsRecord {
 	addresses := []string{}
-	d.Addresses.ElementsAs(context.TODO(), &addresses, false)
+	d.Addresses.ElementsAs(*ctx, &addresses, false)
+	values := []string{}
+	d.Values.ElementsAs(*ctx, &values, false)
 	return sdk.DnsRecord{
 		Name:        d.Name.ValueString(),
 		Description: d.Desc.ValueStringPointer(),
+		Type:        d.Type.ValueStringPointer(),
 		Addresses:   addresses,
+		Values:      values,
+		Ttl:         d.TTL.ValueInt64Pointer(),
 	}
 }
 
 // converts record from Kowabunga API model to Terraform model
 func recordModelToResource(r *sdk.DnsRecord, d *DnsRecordResourceModel) {
+	if r == nil {
+		return
+	}
+
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
+	d.Desc = descModelToResource(r.Description)
+	if r.Type != nil {
+		d.Type = types.StringPointerValue(r.Type)
 	} else {
-		d.Desc = types.StringValue("")
+		d.Type = types.StringValue(DnsRecordDefaultValueType)
 	}
 	addresses := []attr.Value{}
 	for _, a := range r.Addresses {
 		addresses = append(addresses, types.StringValue(a))
 	}
 	d.Addresses, _ = types.ListValue(types.StringType, addresses)
+	values := []attr.Value{}
+	for _, v := range r.Values {
+		values = append(values, types.StringValue(v))
+	}
+	d.Values, _ = types.ListValue(types.StringType, values)
+	if r.Ttl != nil {
+		d.TTL = types.Int64PointerValue(r.Ttl)
+	} else {
+		d.TTL = types.Int64Value(DnsRecordDefaultValueTTL)
+	}
 }
 
 func (r *DnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -101,7 +245,7 @@ func (r *DnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -115,17 +259,18 @@ func (r *DnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 	// find parent project
 	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, DnsRecordResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new record
-	m := recordResourceToModel(data)
+	m := recordResourceToModel(&ctx, data)
 	record, _, err := r.Data.K.ProjectAPI.CreateProjectDnsRecord(ctx, projectId).DnsRecord(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, DnsRecordResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(record.Id)
+	recordModelToResource(record, data) // read back resulting object
 	tflog.Trace(ctx, "created DNS record resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -137,7 +282,7 @@ func (r *DnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -151,7 +296,7 @@ func (r *DnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 	record, _, err := r.Data.K.RecordAPI.ReadDnsRecord(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
 		tflog.Trace(ctx, err.Error())
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, DnsRecordResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -166,7 +311,7 @@ func (r *DnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -177,10 +322,10 @@ func (r *DnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := recordResourceToModel(data)
+	m := recordResourceToModel(&ctx, data)
 	_, _, err := r.Data.K.RecordAPI.UpdateDnsRecord(ctx, data.ID.ValueString()).DnsRecord(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, DnsRecordResourceName, data.Name.ValueString())
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -192,12 +337,12 @@ func (r *DnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, cancel := context.WithTimeout(ctx, timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	r.Data.Mutex.Lock()
@@ -205,7 +350,7 @@ func (r *DnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	_, err := r.Data.K.RecordAPI.DeleteDnsRecord(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, DnsRecordResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DnsRecordsResourceName = "dns_records"
+)
+
+var _ resource.Resource = &DnsRecordsResource{}
+var _ resource.ResourceWithImportState = &DnsRecordsResource{}
+var _ resource.ResourceWithValidateConfig = &DnsRecordsResource{}
+
+func NewDnsRecordsResource() resource.Resource {
+	return &DnsRecordsResource{}
+}
+
+type DnsRecordsResource struct {
+	Data *KowabungaProviderData
+}
+
+type DnsRecordsResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Desc     types.String   `tfsdk:"desc"`
+	Project  types.String   `tfsdk:"project"`
+	Records  types.Map      `tfsdk:"records"` // name => addresses
+	TTL      types.Int64    `tfsdk:"ttl"`
+	// read-only
+	RecordIDs types.Map `tfsdk:"record_ids"` // name => ID
+}
+
+func (r *DnsRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resourceMetadata(req, resp, DnsRecordsResourceName)
+}
+
+func (r *DnsRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// records set is identified by its project, adopting all of its A/AAAA records
+	resourceImportState(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root(KeyProject), req, resp)
+}
+
+func (r *DnsRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Data = resourceConfigure(req, resp)
+}
+
+func (r *DnsRecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of DNS A/AAAA records of a project altogether, in a single reconciled resource. Records type is inferred from their addresses IP family. Should not be used along with **kowabunga_dns_record** resources for the same record names.",
+		Attributes: map[string]schema.Attribute{
+			KeyProject: schema.StringAttribute{
+				MarkdownDescription: "Associated project name or ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyRecords: schema.MapAttribute{
+				MarkdownDescription: "Map of DNS record names to their list of IP addresses (either all IPv4 for A records or all IPv6 for AAAA records)",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueListsAre(
+						listvalidator.SizeAtLeast(1),
+						listvalidator.ValueStringsAre(&stringIPAddressValidator{}),
+					),
+				},
+			},
+			KeyTTL: schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The DNS records time-to-live, in seconds (between %d and %d). Default is `%d`", DnsRecordMinValueTTL, DnsRecordMaxValueTTL, DnsRecordDefaultValueTTL),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(DnsRecordDefaultValueTTL),
+				Validators: []validator.Int64{
+					int64validator.Between(DnsRecordMinValueTTL, DnsRecordMaxValueTTL),
+				},
+			},
+			KeyRecordIDs: schema.MapAttribute{
+				MarkdownDescription: "Map of DNS record names to their internal identifier (read-only)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, resourceAttributesWithoutName(&ctx))
+}
+
+func (r *DnsRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DnsRecordsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Records.IsUnknown() || data.Records.IsNull() {
+		return
+	}
+
+	// a record can't mix up IP families
+	records := map[string][]types.String{}
+	resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &records, false)...)
+	for name, addresses := range records {
+		families := map[bool]bool{}
+		for _, a := range addresses {
+			ip := net.ParseIP(a.ValueString())
+			if ip == nil {
+				// either unknown or already reported by element validator
+				continue
+			}
+			families[ip.To4() != nil] = true
+		}
+		if len(families) > 1 {
+			resp.Diagnostics.AddAttributeError(path.Root(KeyRecords).AtMapKey(name), ErrorInvalidDnsRecord,
+				fmt.Sprintf("%s: %s record can't mix IPv4 and IPv6 addresses", ErrorInvalidDnsRecord, name))
+		}
+	}
+}
+
+// infers record type from its addresses IP family
+func dnsRecordsType(addresses []string) string {
+	if len(addresses) > 0 {
+		ip := net.ParseIP(addresses[0])
+		if ip != nil && ip.To4() == nil {
+			return DnsRecordTypeAAAA
+		}
+	}
+	return DnsRecordTypeA
+}
+
+// converts named record from Terraform model to Kowabunga API model
+func dnsRecordsResourceToModel(d *DnsRecordsResourceModel, name string, addresses []string) sdk.DnsRecord {
+	recordType := dnsRecordsType(addresses)
+	return sdk.DnsRecord{
+		Name:        name,
+		Description: d.Desc.ValueStringPointer(),
+		Type:        &recordType,
+		Addresses:   addresses,
+		Values:      []string{},
+		Ttl:         d.TTL.ValueInt64Pointer(),
+	}
+}
+
+// converts records from Kowabunga API model to Terraform model
+func dnsRecordsModelToResource(records []sdk.DnsRecord, d *DnsRecordsResourceModel) {
+	addresses := map[string]attr.Value{}
+	ids := map[string]attr.Value{}
+	for _, r := range records {
+		a := []attr.Value{}
+		for _, ip := range r.Addresses {
+			a = append(a, types.StringValue(ip))
+		}
+		addresses[r.Name], _ = types.ListValue(types.StringType, a)
+		ids[r.Name] = types.StringPointerValue(r.Id)
+		if r.Ttl != nil {
+			d.TTL = types.Int64PointerValue(r.Ttl)
+		}
+	}
+	d.Records, _ = types.MapValue(types.ListType{ElemType: types.StringType}, addresses)
+	d.RecordIDs, _ = types.MapValue(types.StringType, ids)
+}
+
+// creates, updates and deletes project's records until they match declared ones
+func dnsRecordsReconcile(ctx context.Context, data *KowabungaProviderData, d *DnsRecordsResourceModel, projectId string, current map[string]string) ([]sdk.DnsRecord, error) {
+	records := map[string][]string{}
+	d.Records.ElementsAs(ctx, &records, false)
+
+	result := []sdk.DnsRecord{}
+	for _, name := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := records[name]; ok {
+			continue
+		}
+		_, err := data.K.RecordAPI.DeleteDnsRecord(ctx, current[name]).Execute()
+		if err != nil && !apiErrorIsNotFound(err) {
+			return result, err
+		}
+		tflog.Trace(ctx, "Deleted "+current[name])
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(records)) {
+		m := dnsRecordsResourceToModel(d, name, records[name])
+		if id, ok := current[name]; ok {
+			record, _, err := data.K.RecordAPI.UpdateDnsRecord(ctx, id).DnsRecord(m).Execute()
+			if err != nil {
+				return result, err
+			}
+			result = append(result, *record)
+			continue
+		}
+		record, _, err := data.K.ProjectAPI.CreateProjectDnsRecord(ctx, projectId).DnsRecord(m).Execute()
+		if err != nil {
+			return result, err
+		}
+		result = append(result, *record)
+	}
+
+	return result, nil
+}
+
+// retrieves records set currently known identifiers
+func dnsRecordsIDs(ctx context.Context, d *DnsRecordsResourceModel) map[string]string {
+	ids := map[string]string{}
+	if !d.RecordIDs.IsNull() && !d.RecordIDs.IsUnknown() {
+		d.RecordIDs.ElementsAs(ctx, &ids, false)
+	}
+	return ids
+}
+
+func (r *DnsRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DnsRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	// find parent project
+	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
+	if err != nil {
+		errorCreateGeneric(resp, err, DnsRecordsResourceName, data.Project.ValueString())
+		return
+	}
+	data.ID = types.StringValue(projectId)
+
+	// create all records
+	records, err := dnsRecordsReconcile(ctx, r.Data, data, projectId, map[string]string{})
+	dnsRecordsModelToResource(records, data) // read back resulting objects
+	if err != nil {
+		// keep track of already created records, for them to be cleaned up
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		errorCreateGeneric(resp, err, DnsRecordsResourceName, data.Project.ValueString())
+		return
+	}
+	tflog.Trace(ctx, "created DNS records resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DnsRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	// imported records set, adopt all of project's address records
+	ids := dnsRecordsIDs(ctx, data)
+	if data.RecordIDs.IsNull() {
+		projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
+		if err != nil {
+			errorReadGeneric(resp, err, DnsRecordsResourceName, data.Project.ValueString())
+			return
+		}
+		data.ID = types.StringValue(projectId)
+		all, _, err := r.Data.K.ProjectAPI.ListProjectDnsRecords(ctx, projectId).Execute()
+		if err != nil {
+			errorReadGeneric(resp, err, DnsRecordsResourceName, data.Project.ValueString())
+			return
+		}
+		for _, id := range all {
+			ids[id] = id
+		}
+	}
+
+	records := []sdk.DnsRecord{}
+	for _, id := range ids {
+		record, _, err := r.Data.K.RecordAPI.ReadDnsRecord(ctx, id).Execute()
+		if err != nil {
+			if apiErrorIsNotFound(err) {
+				// vanished record, to be re-created
+				continue
+			}
+			errorReadGeneric(resp, err, DnsRecordsResourceName, data.Project.ValueString())
+			return
+		}
+		if record.Type != nil && *record.Type != DnsRecordTypeA && *record.Type != DnsRecordTypeAAAA {
+			continue
+		}
+		records = append(records, *record)
+	}
+
+	dnsRecordsModelToResource(records, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DnsRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *DnsRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	records, err := dnsRecordsReconcile(ctx, r.Data, data, data.ID.ValueString(), dnsRecordsIDs(ctx, state))
+	if err != nil {
+		errorUpdateGeneric(resp, err, DnsRecordsResourceName, data.Project.ValueString())
+		return
+	}
+
+	dnsRecordsModelToResource(records, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DnsRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	for _, id := range dnsRecordsIDs(ctx, data) {
+		_, err := r.Data.K.RecordAPI.DeleteDnsRecord(ctx, id).Execute()
+		if err != nil && !apiErrorIsNotFound(err) {
+			errorDeleteGeneric(resp, err, DnsRecordsResourceName, data.Project.ValueString())
+			return
+		}
+		tflog.Trace(ctx, "Deleted "+id)
+	}
+}
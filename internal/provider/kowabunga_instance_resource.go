@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
+	"slices"
 	"sort"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
@@ -11,12 +13,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	InstanceResourceName = "instance"
+
+	InstanceDefaultValueIgnoreAttachments = false
 )
 
 var _ resource.Resource = &InstanceResource{}
@@ -31,16 +40,39 @@ type InstanceResource struct {
 }
 
 type InstanceResourceModel struct {
-	ID       types.String   `tfsdk:"id"`
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
-	Name     types.String   `tfsdk:"name"`
-	Desc     types.String   `tfsdk:"desc"`
-	Project  types.String   `tfsdk:"project"`
-	Zone     types.String   `tfsdk:"zone"`
-	VCPUs    types.Int64    `tfsdk:"vcpus"`
-	Memory   types.Int64    `tfsdk:"mem"`
-	Adapters types.List     `tfsdk:"adapters"`
-	Volumes  types.List     `tfsdk:"volumes"`
+	ID        types.String   `tfsdk:"id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+	Name      types.String   `tfsdk:"name"`
+	Desc      types.String   `tfsdk:"desc"`
+	Project   types.String   `tfsdk:"project"`
+	Zone      types.String   `tfsdk:"zone"`
+	VCPUs     types.Int64    `tfsdk:"vcpus"`
+	Memory    types.Int64    `tfsdk:"mem"`
+	Adapters  types.List     `tfsdk:"adapters"`
+	Volumes   types.List     `tfsdk:"volumes"`
+	IAdapters types.List     `tfsdk:"inline_adapters"`
+	IVolumes  types.List     `tfsdk:"inline_volumes"`
+	Notify    types.Bool     `tfsdk:"notify"`
+	// semi-managed attachments
+	IgnoreAttachments types.Bool `tfsdk:"ignore_attachments_after_create"`
+}
+
+type InstanceInlineAdapterModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Subnet    types.String `tfsdk:"subnet"`
+	MAC       types.String `tfsdk:"hwaddress"`
+	Addresses types.List   `tfsdk:"addresses"`
+	Assign    types.Bool   `tfsdk:"assign"`
+}
+
+type InstanceInlineVolumeModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Pool     types.String `tfsdk:"pool"`
+	Template types.String `tfsdk:"template"`
+	Type     types.String `tfsdk:"type"`
+	Size     types.Int64  `tfsdk:"size"`
 }
 
 func (r *InstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,24 +80,110 @@ func (r *InstanceResource) Metadata(ctx context.Context, req resource.MetadataRe
 }
 
 func (r *InstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateComposite(ctx, req, resp, KeyProject, KeyZone)
 }
 
 func (r *InstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.Data = resourceConfigure(req, resp)
 }
 
+func (r *InstanceResource) SchemaInlineAdapter() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			KeyID: schema.StringAttribute{
+				MarkdownDescription: "Network adapter internal identifier (read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyName: schema.StringAttribute{
+				MarkdownDescription: "Network adapter name",
+				Required:            true,
+			},
+			KeySubnet: schema.StringAttribute{
+				MarkdownDescription: "Associated subnet name or ID",
+				Required:            true,
+			},
+			KeyMAC: schema.StringAttribute{
+				MarkdownDescription: "Network adapter hardware MAC address (e.g. 00:11:22:33:44:55). Auto-generated if unspecified.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyAddresses: schema.ListAttribute{
+				MarkdownDescription: "Network adapter list of associated IPv4 addresses. Auto-assigned if unspecified and **assign** is set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyAssign: schema.BoolAttribute{
+				MarkdownDescription: "Whether an IP address shall be automatically assigned to the adapter (default: **true**)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(AdapterDefaultValueAssign),
+			},
+		},
+	}
+}
+
+func (r *InstanceResource) SchemaInlineVolume() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			KeyID: schema.StringAttribute{
+				MarkdownDescription: "Storage volume internal identifier (read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyName: schema.StringAttribute{
+				MarkdownDescription: "Storage volume name",
+				Required:            true,
+			},
+			KeyPool: schema.StringAttribute{
+				MarkdownDescription: "Associated storage pool name or ID (region's default if unspecified)",
+				Optional:            true,
+			},
+			KeyTemplate: schema.StringAttribute{
+				MarkdownDescription: "The template name or ID",
+				Optional:            true,
+			},
+			KeyType: schema.StringAttribute{
+				MarkdownDescription: "The volume type (valid options: 'os', 'iso', 'raw')",
+				Required:            true,
+			},
+			KeySize: schema.Int64Attribute{
+				MarkdownDescription: "The volume size (expressed in GB)",
+				Required:            true,
+			},
+		},
+	}
+}
+
 func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	empty, _ := types.ListValue(types.StringType, []attr.Value{})
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a raw virtual machine instance resource. Usage of instance resource requires preliminary creation of network adapters and storage volumes to be associated with the instance. It comes handy when one wants to deploy a specifically tuned virtual machine's configuration. For common usage, it is recommended to use the **kce** resource instead, which provides a standard ready-to-be-used virtual machine, offloading much of the complexity.",
+		MarkdownDescription: "Manages a raw virtual machine instance resource. Usage of instance resource requires preliminary creation of network adapters and storage volumes to be associated with the instance, or their inline declaration, in which case they are created and destroyed along with the instance. It comes handy when one wants to deploy a specifically tuned virtual machine's configuration. For common usage, it is recommended to use the **kce** resource instead, which provides a standard ready-to-be-used virtual machine, offloading much of the complexity.",
 		Attributes: map[string]schema.Attribute{
 			KeyProject: schema.StringAttribute{
 				MarkdownDescription: "Associated project name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyZone: schema.StringAttribute{
 				MarkdownDescription: "Associated zone name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyVCPUs: schema.Int64Attribute{
 				MarkdownDescription: "The instance number of vCPUs",
@@ -76,27 +194,253 @@ func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaReques
 				Required:            true,
 			},
 			KeyAdapters: schema.ListAttribute{
-				MarkdownDescription: "The list of network adapters to be associated with the instance",
+				MarkdownDescription: "The list of pre-existing network adapters to be associated with the instance",
 				ElementType:         types.StringType,
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(empty),
 			},
 			KeyVolumes: schema.ListAttribute{
-				MarkdownDescription: "The list of storage volumes to be associated with the instance",
+				MarkdownDescription: "The list of pre-existing storage volumes to be associated with the instance",
 				ElementType:         types.StringType,
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(empty),
+			},
+			KeyNotify: resourceAttributeNotify("instance"),
+			KeyIgnoreAttachments: schema.BoolAttribute{
+				MarkdownDescription: "Whether pre-existing network adapters and storage volumes attachments should be ignored once the instance has been created (default: **false**). Useful for semi-managed instances whose NICs/disks are hot-plugged outside of Terraform: live attachments are then neither reported as drift nor detached on update, and later changes to **adapters** and **volumes** are not applied.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(InstanceDefaultValueIgnoreAttachments),
+			},
+			KeyInlineAdapters: schema.ListNestedAttribute{
+				MarkdownDescription: "The list of network adapters to be created along with the instance, and destroyed with it",
+				NestedObject:        r.SchemaInlineAdapter(),
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyInlineVolumes: schema.ListNestedAttribute{
+				MarkdownDescription: "The list of storage volumes to be created along with the instance, and destroyed with it",
+				NestedObject:        r.SchemaInlineVolume(),
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
+// finds zone's parent region, where storage volumes are to be created
+func getZoneRegionID(ctx context.Context, data *KowabungaProviderData, zoneId string) (string, error) {
+	regions, _, err := data.K.RegionAPI.ListRegions(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+	for _, rg := range regions {
+		zones, _, err := data.K.RegionAPI.ListRegionZones(ctx, rg).Execute()
+		if err == nil && slices.Contains(zones, zoneId) {
+			return rg, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s", ErrorUnknownRegion)
+}
+
+// creates instance's inline network adapters
+func instanceCreateInlineAdapters(ctx context.Context, data *KowabungaProviderData, d *InstanceResourceModel) error {
+	adapters := []InstanceInlineAdapterModel{}
+	d.IAdapters.ElementsAs(ctx, &adapters, false)
+	for i, a := range adapters {
+		subnetId, err := getSubnetID(ctx, data, a.Subnet.ValueString())
+		if err != nil {
+			return fmt.Errorf("%s: %s", err.Error(), a.Subnet.ValueString())
+		}
+
+		addresses := []string{}
+		if !a.Addresses.IsUnknown() {
+			a.Addresses.ElementsAs(ctx, &addresses, false)
+		}
+		m := sdk.Adapter{
+			Name:      a.Name.ValueString(),
+			Addresses: addresses,
+		}
+		if !a.MAC.IsUnknown() {
+			m.Mac = a.MAC.ValueStringPointer()
+		}
+		api := data.K.SubnetAPI.CreateAdapter(ctx, subnetId).Adapter(m)
+		if a.Assign.ValueBool() && len(m.Addresses) == 0 {
+			api = api.AssignIP(a.Assign.ValueBool())
+		}
+		adapter, _, err := api.Execute()
+		if err != nil {
+			return err
+		}
+
+		adapters[i].ID = types.StringPointerValue(adapter.Id)
+		adapters[i].MAC = types.StringPointerValue(adapter.Mac)
+		adapters[i].Addresses, _ = types.ListValueFrom(ctx, types.StringType, adapter.Addresses)
+		// keep track of created adapters
+		d.IAdapters = instanceInlineAdaptersToResource(adapters)
+	}
+
+	return nil
+}
+
+// creates instance's inline storage volumes
+func instanceCreateInlineVolumes(ctx context.Context, data *KowabungaProviderData, d *InstanceResourceModel, projectId string, zoneId string) error {
+	volumes := []InstanceInlineVolumeModel{}
+	d.IVolumes.ElementsAs(ctx, &volumes, false)
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	regionId, err := getZoneRegionID(ctx, data, zoneId)
+	if err != nil {
+		return err
+	}
+
+	for i, v := range volumes {
+		m := sdk.Volume{
+			Name: v.Name.ValueString(),
+			Type: v.Type.ValueString(),
+			Size: v.Size.ValueInt64() * HelperGbToBytes,
+		}
+		api := data.K.ProjectAPI.CreateProjectRegionVolume(ctx, projectId, regionId).Volume(m)
+		if !v.Pool.IsNull() {
+			poolId, err := getPoolID(ctx, data, v.Pool.ValueString())
+			if err != nil {
+				return fmt.Errorf("%s: %s", err.Error(), v.Pool.ValueString())
+			}
+			api = api.PoolId(poolId)
+		}
+		if !v.Template.IsNull() {
+			templateId, err := getTemplateID(ctx, data, v.Template.ValueString())
+			if err != nil {
+				return fmt.Errorf("%s: %s", err.Error(), v.Template.ValueString())
+			}
+			api = api.TemplateId(templateId)
+		}
+		volume, _, err := api.Execute()
+		if err != nil {
+			return err
+		}
+
+		volumes[i].ID = types.StringPointerValue(volume.Id)
+		// keep track of created volumes
+		d.IVolumes = instanceInlineVolumesToResource(volumes)
+	}
+
+	return nil
+}
+
+// deletes instance's inline network adapters and storage volumes
+func instanceDeleteInline(ctx context.Context, data *KowabungaProviderData, d *InstanceResourceModel) error {
+	for _, id := range instanceInlineAdapterIDs(&ctx, d) {
+		_, err := data.K.AdapterAPI.DeleteAdapter(ctx, id).Execute()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range instanceInlineVolumeIDs(&ctx, d) {
+		_, err := data.K.VolumeAPI.DeleteVolume(ctx, id).Execute()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func instanceInlineAdapterIDs(ctx *context.Context, d *InstanceResourceModel) []string {
+	ids := []string{}
+	adapters := []InstanceInlineAdapterModel{}
+	d.IAdapters.ElementsAs(*ctx, &adapters, false)
+	for _, a := range adapters {
+		if !a.ID.IsUnknown() && !a.ID.IsNull() {
+			ids = append(ids, a.ID.ValueString())
+		}
+	}
+	return ids
+}
+
+func instanceInlineVolumeIDs(ctx *context.Context, d *InstanceResourceModel) []string {
+	ids := []string{}
+	volumes := []InstanceInlineVolumeModel{}
+	d.IVolumes.ElementsAs(*ctx, &volumes, false)
+	for _, v := range volumes {
+		if !v.ID.IsUnknown() && !v.ID.IsNull() {
+			ids = append(ids, v.ID.ValueString())
+		}
+	}
+	return ids
+}
+
+func instanceInlineAdaptersToResource(adapters []InstanceInlineAdapterModel) types.List {
+	adapterType := map[string]attr.Type{
+		KeyID:        types.StringType,
+		KeyName:      types.StringType,
+		KeySubnet:    types.StringType,
+		KeyMAC:       types.StringType,
+		KeyAddresses: types.ListType{ElemType: types.StringType},
+		KeyAssign:    types.BoolType,
+	}
+	objects := []attr.Value{}
+	for _, a := range adapters {
+		object, _ := types.ObjectValue(adapterType, map[string]attr.Value{
+			KeyID:        a.ID,
+			KeyName:      a.Name,
+			KeySubnet:    a.Subnet,
+			KeyMAC:       a.MAC,
+			KeyAddresses: a.Addresses,
+			KeyAssign:    a.Assign,
+		})
+		objects = append(objects, object)
+	}
+	list, _ := types.ListValue(types.ObjectType{AttrTypes: adapterType}, objects)
+	return list
+}
+
+func instanceInlineVolumesToResource(volumes []InstanceInlineVolumeModel) types.List {
+	volumeType := map[string]attr.Type{
+		KeyID:       types.StringType,
+		KeyName:     types.StringType,
+		KeyPool:     types.StringType,
+		KeyTemplate: types.StringType,
+		KeyType:     types.StringType,
+		KeySize:     types.Int64Type,
+	}
+	objects := []attr.Value{}
+	for _, v := range volumes {
+		object, _ := types.ObjectValue(volumeType, map[string]attr.Value{
+			KeyID:       v.ID,
+			KeyName:     v.Name,
+			KeyPool:     v.Pool,
+			KeyTemplate: v.Template,
+			KeyType:     v.Type,
+			KeySize:     v.Size,
+		})
+		objects = append(objects, object)
+	}
+	list, _ := types.ListValue(types.ObjectType{AttrTypes: volumeType}, objects)
+	return list
+}
+
 // converts instance from Terraform model to Kowabunga API model
 func instanceResourceToModel(d *InstanceResourceModel) sdk.Instance {
+	ctx := context.TODO()
 	memSize := d.Memory.ValueInt64() * HelperGbToBytes
 	adapters := []string{}
-	d.Adapters.ElementsAs(context.TODO(), &adapters, false)
+	d.Adapters.ElementsAs(ctx, &adapters, false)
+	adapters = append(adapters, instanceInlineAdapterIDs(&ctx, d)...)
 	volumes := []string{}
-	d.Volumes.ElementsAs(context.TODO(), &volumes, false)
+	d.Volumes.ElementsAs(ctx, &volumes, false)
+	volumes = append(volumes, instanceInlineVolumeIDs(&ctx, d)...)
 	sort.Strings(volumes)
 
 	return sdk.Instance{
@@ -117,21 +461,27 @@ func instanceModelToResource(r *sdk.Instance, d *InstanceResourceModel) {
 
 	memSize := r.Memory / HelperGbToBytes
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	d.VCPUs = types.Int64Value(r.Vcpus)
 	d.Memory = types.Int64Value(memSize)
+	// inline adapters and volumes are tracked apart
+	ctx := context.TODO()
+	inlineAdapters := instanceInlineAdapterIDs(&ctx, d)
+	inlineVolumes := instanceInlineVolumeIDs(&ctx, d)
 	adapters := []attr.Value{}
 	for _, a := range r.Adapters {
+		if slices.Contains(inlineAdapters, a) {
+			continue
+		}
 		adapters = append(adapters, types.StringValue(a))
 	}
 	d.Adapters, _ = types.ListValue(types.StringType, adapters)
 	volumes := []attr.Value{}
 	sort.Strings(r.Volumes)
 	for _, v := range r.Volumes {
+		if slices.Contains(inlineVolumes, v) {
+			continue
+		}
 		volumes = append(volumes, types.StringValue(v))
 	}
 	d.Volumes, _ = types.ListValue(types.StringType, volumes)
@@ -144,7 +494,7 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -158,23 +508,44 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 	// find parent project
 	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent zone
 	zoneId, err := getZoneID(ctx, r.Data, data.Zone.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
+		return
+	}
+	// create inline adapters and volumes, cleaning up on failure
+	err = instanceCreateInlineAdapters(ctx, r.Data, data)
+	if err == nil {
+		err = instanceCreateInlineVolumes(ctx, r.Data, data, projectId, zoneId)
+	}
+	if err != nil {
+		_ = instanceDeleteInline(ctx, r.Data, data)
+		errorCreateGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new instance
 	m := instanceResourceToModel(data)
-	instance, _, err := r.Data.K.ProjectAPI.CreateProjectZoneInstance(ctx, projectId, zoneId).Instance(m).Execute()
+	data.Notify = resourceNotify(r.Data, data.Notify)
+	stop := resourceProgressStart(ctx, "creating", InstanceResourceName, data.Name.ValueString())
+	instance, _, err := r.Data.K.ProjectAPI.CreateProjectZoneInstance(ctx, projectId, zoneId).Instance(m).Notify(data.Notify.ValueBool()).Execute()
+	stop()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		_ = instanceDeleteInline(ctx, r.Data, data)
+		errorCreateGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(instance.Id)
+	resourceWaitState(ctx, InstanceResourceName, data.Name.ValueString(), ProgressStateRunning, func() (string, error) {
+		state, _, err := r.Data.K.InstanceAPI.ReadInstanceState(ctx, *instance.Id).Execute()
+		if err != nil {
+			return "", err
+		}
+		return state.State, nil
+	})
 	instanceModelToResource(instance, data) // read back resulting object
 	tflog.Trace(ctx, "created instance resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -187,7 +558,7 @@ func (r *InstanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -200,10 +571,18 @@ func (r *InstanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	instance, _, err := r.Data.K.InstanceAPI.ReadInstance(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
 		return
 	}
+	// attachments managed outside of Terraform after creation are not reported back
+	adapters, volumes := data.Adapters, data.Volumes
 	instanceModelToResource(instance, data)
+	if data.IgnoreAttachments.ValueBool() {
+		data.Adapters, data.Volumes = adapters, volumes
+	}
+	if data.IgnoreAttachments.IsNull() {
+		data.IgnoreAttachments = types.BoolValue(InstanceDefaultValueIgnoreAttachments)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -214,7 +593,7 @@ func (r *InstanceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -226,11 +605,22 @@ func (r *InstanceResource) Update(ctx context.Context, req resource.UpdateReques
 	defer r.Data.Mutex.Unlock()
 
 	m := instanceResourceToModel(data)
+	// preserve live attachments, whatever the configuration says
+	if data.IgnoreAttachments.ValueBool() {
+		instance, _, err := r.Data.K.InstanceAPI.ReadInstance(ctx, data.ID.ValueString()).Execute()
+		if err != nil {
+			errorUpdateGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
+			return
+		}
+		m.Adapters = instance.Adapters
+		m.Volumes = instance.Volumes
+	}
 	_, _, err := r.Data.K.InstanceAPI.UpdateInstance(ctx, data.ID.ValueString()).Instance(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
 		return
 	}
+	data.Notify = resourceNotify(r.Data, data.Notify)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -241,7 +631,7 @@ func (r *InstanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -254,7 +644,14 @@ func (r *InstanceResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	_, err := r.Data.K.InstanceAPI.DeleteInstance(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
+		return
+	}
+
+	// delete inline adapters and volumes
+	err = instanceDeleteInline(ctx, r.Data, data)
+	if err != nil {
+		errorDeleteGeneric(resp, err, InstanceResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	InstancesDataSourceName = "instances"
+)
+
+var _ datasource.DataSource = &InstancesDataSource{}
+var _ datasource.DataSourceWithConfigure = &InstancesDataSource{}
+
+func NewInstancesDataSource() datasource.DataSource {
+	return &InstancesDataSource{}
+}
+
+type InstancesDataSource struct {
+	Data *KowabungaProviderData
+}
+
+type InstancesDataSourceModel struct {
+	Project   types.String                   `tfsdk:"project"`
+	Zone      types.String                   `tfsdk:"zone"`
+	Instances []InstancesDataSourceItemModel `tfsdk:"instances"`
+}
+
+type InstancesDataSourceItemModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	VCPUs  types.Int64  `tfsdk:"vcpus"`
+	Memory types.Int64  `tfsdk:"mem"`
+	IP     types.String `tfsdk:"ip"`
+}
+
+func (d *InstancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	datasourceMetadata(req, resp, InstancesDataSourceName)
+}
+
+func (d *InstancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Data = datasourceConfigure(req, resp)
+}
+
+func (d *InstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data from instances, optionally filtered by project and/or zone",
+		Attributes: map[string]schema.Attribute{
+			KeyProject: schema.StringAttribute{
+				MarkdownDescription: "Associated project name or ID to filter instances from",
+				Optional:            true,
+			},
+			KeyZone: schema.StringAttribute{
+				MarkdownDescription: "Associated zone name or ID to filter instances from",
+				Optional:            true,
+			},
+			KeyInstances: schema.ListNestedAttribute{
+				MarkdownDescription: "List of Kowabunga instances, sorted by name (read-only)",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						KeyID: schema.StringAttribute{
+							MarkdownDescription: "Instance internal identifier (read-only)",
+							Computed:            true,
+						},
+						KeyName: schema.StringAttribute{
+							MarkdownDescription: "Instance name (read-only)",
+							Computed:            true,
+						},
+						KeyVCPUs: schema.Int64Attribute{
+							MarkdownDescription: "Instance number of vCPUs (read-only)",
+							Computed:            true,
+						},
+						KeyMemory: schema.Int64Attribute{
+							MarkdownDescription: "Instance memory size, expressed in GB (read-only)",
+							Computed:            true,
+						},
+						KeyIP: schema.StringAttribute{
+							MarkdownDescription: "Instance first network adapter IP address, empty if none (read-only)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// lists instances IDs, narrowed down to project and/or zone ones
+func instancesList(ctx context.Context, data *KowabungaProviderData, projectId string, zoneId string) ([]string, error) {
+	var ids []string
+	var err error
+	switch {
+	case projectId != "" && zoneId != "":
+		ids, _, err = data.K.ProjectAPI.ListProjectZoneInstances(ctx, projectId, zoneId).Execute()
+	case projectId != "":
+		ids, _, err = data.K.ProjectAPI.ListProjectInstances(ctx, projectId).Execute()
+	case zoneId != "":
+		ids, _, err = data.K.ZoneAPI.ListZoneInstances(ctx, zoneId).Execute()
+	default:
+		ids, _, err = data.K.InstanceAPI.ListInstances(ctx).Execute()
+	}
+	return ids, err
+}
+
+// returns instance's first network adapter IP address
+func instanceFirstIP(ctx context.Context, data *KowabungaProviderData, instance *sdk.Instance) string {
+	for _, id := range instance.Adapters {
+		adapter, _, err := data.K.AdapterAPI.ReadAdapter(ctx, id).Execute()
+		if err == nil && len(adapter.Addresses) > 0 {
+			return adapter.Addresses[0]
+		}
+	}
+	return ""
+}
+
+func (d *InstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.Data.Mutex.Lock()
+	defer d.Data.Mutex.Unlock()
+
+	projectId := ""
+	if data.Project.ValueString() != "" {
+		id, err := getProjectID(ctx, d.Data, data.Project.ValueString())
+		if err != nil {
+			errorDataSourceReadGeneric(resp, err)
+			return
+		}
+		projectId = id
+	}
+
+	zoneId := ""
+	if data.Zone.ValueString() != "" {
+		id, err := getZoneID(ctx, d.Data, data.Zone.ValueString())
+		if err != nil {
+			errorDataSourceReadGeneric(resp, err)
+			return
+		}
+		zoneId = id
+	}
+
+	instances, err := instancesList(ctx, d.Data, projectId, zoneId)
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	data.Instances = []InstancesDataSourceItemModel{}
+	for _, id := range instances {
+		instance, _, err := d.Data.K.InstanceAPI.ReadInstance(ctx, id).Execute()
+		if err != nil {
+			continue
+		}
+		data.Instances = append(data.Instances, InstancesDataSourceItemModel{
+			ID:     types.StringPointerValue(instance.Id),
+			Name:   types.StringValue(instance.Name),
+			VCPUs:  types.Int64Value(instance.Vcpus),
+			Memory: types.Int64Value(instance.Memory / HelperGbToBytes),
+			IP:     types.StringValue(instanceFirstIP(ctx, d.Data, instance)),
+		})
+	}
+	slices.SortFunc(data.Instances, func(a, b InstancesDataSourceItemModel) int {
+		return cmp.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	IPAllocationDataSourceName = "ip_allocation"
+
+	IPAllocationDataSourceErrNoFreeAddress = "no free IP address left in subnet"
+)
+
+var _ datasource.DataSource = &IPAllocationDataSource{}
+var _ datasource.DataSourceWithConfigure = &IPAllocationDataSource{}
+
+func NewIPAllocationDataSource() datasource.DataSource {
+	return &IPAllocationDataSource{}
+}
+
+type IPAllocationDataSource struct {
+	Data *KowabungaProviderData
+}
+
+type IPAllocationDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Subnet  types.String `tfsdk:"subnet"`
+	Address types.String `tfsdk:"address"`
+}
+
+func (d *IPAllocationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	datasourceMetadata(req, resp, IPAllocationDataSourceName)
+}
+
+func (d *IPAllocationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Data = datasourceConfigure(req, resp)
+}
+
+func (d *IPAllocationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data from a subnet's next available IP address, skipping gateway, reserved ranges and addresses already assigned to adapters",
+		Attributes: map[string]schema.Attribute{
+			KeyID: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DataSourceIdDescription,
+			},
+			KeySubnet: schema.StringAttribute{
+				MarkdownDescription: "Associated subnet name or ID",
+				Required:            true,
+			},
+			KeyAddress: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Next available IP address in subnet (read-only)",
+			},
+		},
+	}
+}
+
+// checks whether IP address belongs to any of the IP ranges
+func ipAllocationInRanges(ip netip.Addr, ranges []sdk.IpRange) bool {
+	for _, r := range ranges {
+		first, err := netip.ParseAddr(r.First)
+		if err != nil {
+			continue
+		}
+		last, err := netip.ParseAddr(r.Last)
+		if err != nil {
+			continue
+		}
+		if ip.Compare(first) >= 0 && ip.Compare(last) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// returns subnet's IP addresses already in use
+func ipAllocationUsedAddresses(ctx context.Context, data *KowabungaProviderData, subnet *sdk.Subnet) (map[string]bool, error) {
+	used := map[string]bool{
+		subnet.Gateway: true,
+	}
+
+	adapters, _, err := data.K.SubnetAPI.ListSubnetAdapters(ctx, *subnet.Id).Execute()
+	if err != nil {
+		return used, err
+	}
+	for _, an := range adapters {
+		adapter, _, err := data.K.AdapterAPI.ReadAdapter(ctx, an).Execute()
+		if err != nil {
+			return used, err
+		}
+		for _, a := range adapter.Addresses {
+			used[a] = true
+		}
+	}
+
+	return used, nil
+}
+
+// finds subnet's first IP address neither used nor reserved
+func ipAllocationNextFree(subnet *sdk.Subnet, used map[string]bool) (string, error) {
+	prefix, err := netip.ParsePrefix(subnet.Cidr)
+	if err != nil {
+		return "", err
+	}
+	prefix = prefix.Masked()
+
+	// skip network address
+	for ip := prefix.Addr().Next(); prefix.Contains(ip); ip = ip.Next() {
+		// skip IPv4 broadcast address
+		if ip.Is4() && !prefix.Contains(ip.Next()) {
+			break
+		}
+		if used[ip.String()] || ipAllocationInRanges(ip, subnet.Reserved) || ipAllocationInRanges(ip, subnet.GwPool) {
+			continue
+		}
+		return ip.String(), nil
+	}
+
+	return "", fmt.Errorf("%s: %s", IPAllocationDataSourceErrNoFreeAddress, subnet.Cidr)
+}
+
+func (d *IPAllocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IPAllocationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.Data.Mutex.Lock()
+	defer d.Data.Mutex.Unlock()
+
+	subnetId, err := getSubnetID(ctx, d.Data, data.Subnet.ValueString())
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	subnet, _, err := d.Data.K.SubnetAPI.ReadSubnet(ctx, subnetId).Execute()
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	used, err := ipAllocationUsedAddresses(ctx, d.Data, subnet)
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	address, err := ipAllocationNextFree(subnet, used)
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	data.ID = types.StringPointerValue(subnet.Id)
+	data.Address = types.StringValue(address)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -7,12 +7,13 @@ import (
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -55,7 +56,7 @@ func (r *KaktusResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *KaktusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, KeyZone, getKaktusID)
 }
 
 func (r *KaktusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -69,6 +70,9 @@ func (r *KaktusResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			KeyZone: schema.StringAttribute{
 				MarkdownDescription: "Associated zone name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyCpuPrice: schema.Float64Attribute{
 				MarkdownDescription: "Kaktus node monthly CPU price value (default: 0)",
@@ -101,7 +105,7 @@ func (r *KaktusResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:             int64default.StaticInt64(KaktusDefaultValueMemoryOverCommit),
 			},
 			KeyAgents: schema.ListAttribute{
-				MarkdownDescription: "The list of Kowabunga remote agents to be associated with the kaktus node",
+				MarkdownDescription: "The list of Kowabunga remote agents (name or ID) to be associated with the kaktus node",
 				ElementType:         types.StringType,
 				Required:            true,
 			},
@@ -111,9 +115,7 @@ func (r *KaktusResource) Schema(ctx context.Context, req resource.SchemaRequest,
 }
 
 // converts kaktus from Terraform model to Kowabunga API model
-func kaktusResourceToModel(d *KaktusResourceModel) sdk.Kaktus {
-	agents := []string{}
-	d.Agents.ElementsAs(context.TODO(), &agents, false)
+func kaktusResourceToModel(ctx *context.Context, d *KaktusResourceModel, agents map[string]string) sdk.Kaktus {
 
 	return sdk.Kaktus{
 		Name:        d.Name.ValueString(),
@@ -128,22 +130,18 @@ func kaktusResourceToModel(d *KaktusResourceModel) sdk.Kaktus {
 		},
 		OvercommitCpuRatio:    d.CpuOvercommit.ValueInt64Pointer(),
 		OvercommitMemoryRatio: d.MemoryOvercommit.ValueInt64Pointer(),
-		Agents:                agents,
+		Agents:                agentsResourceToModel(ctx, d.Agents, agents),
 	}
 }
 
 // converts kaktus from Kowabunga API model to Terraform model
-func kaktusModelToResource(r *sdk.Kaktus, d *KaktusResourceModel) {
+func kaktusModelToResource(r *sdk.Kaktus, d *KaktusResourceModel, agents map[string]string) {
 	if r == nil {
 		return
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	d.CpuPrice = types.Float64Value(float64(r.CpuCost.Price))
 	d.Currency = types.StringValue(r.CpuCost.Currency)
 	d.MemoryPrice = types.Float64Value(float64(r.MemoryCost.Price))
@@ -157,11 +155,7 @@ func kaktusModelToResource(r *sdk.Kaktus, d *KaktusResourceModel) {
 	} else {
 		d.MemoryOvercommit = types.Int64Value(KaktusDefaultValueMemoryOverCommit)
 	}
-	agents := []attr.Value{}
-	for _, a := range r.Agents {
-		agents = append(agents, types.StringValue(a))
-	}
-	d.Agents, _ = types.ListValue(types.StringType, agents)
+	d.Agents = agentsModelToResource(r.Agents, agents)
 }
 
 func (r *KaktusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -171,7 +165,7 @@ func (r *KaktusResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -185,18 +179,24 @@ func (r *KaktusResource) Create(ctx context.Context, req resource.CreateRequest,
 	// find parent zone
 	zoneId, err := getZoneID(ctx, r.Data, data.Zone.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KaktusResourceName, data.Name.ValueString())
+		return
+	}
+	// find associated agents
+	agents, err := getAgentIDs(ctx, r.Data, data.Agents)
+	if err != nil {
+		errorCreateGeneric(resp, err, KaktusResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new kaktus
-	m := kaktusResourceToModel(data)
+	m := kaktusResourceToModel(&ctx, data, agents)
 	kaktus, _, err := r.Data.K.ZoneAPI.CreateKaktus(ctx, zoneId).Kaktus(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KaktusResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(kaktus.Id)
-	kaktusModelToResource(kaktus, data) // read back resulting object
+	kaktusModelToResource(kaktus, data, agents) // read back resulting object
 	tflog.Trace(ctx, "created kaktus resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -208,7 +208,7 @@ func (r *KaktusResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -221,11 +221,13 @@ func (r *KaktusResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	kaktus, _, err := r.Data.K.KaktusAPI.ReadKaktus(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, KaktusResourceName, data.Name.ValueString())
 		return
 	}
 
-	kaktusModelToResource(kaktus, data)
+	// unresolvable agents are reported as drift
+	agents, _ := getAgentIDs(ctx, r.Data, data.Agents)
+	kaktusModelToResource(kaktus, data, agents)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -236,7 +238,7 @@ func (r *KaktusResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -247,10 +249,16 @@ func (r *KaktusResource) Update(ctx context.Context, req resource.UpdateRequest,
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := kaktusResourceToModel(data)
-	_, _, err := r.Data.K.KaktusAPI.UpdateKaktus(ctx, data.ID.ValueString()).Kaktus(m).Execute()
+	agents, err := getAgentIDs(ctx, r.Data, data.Agents)
+	if err != nil {
+		errorUpdateGeneric(resp, err, KaktusResourceName, data.Name.ValueString())
+		return
+	}
+
+	m := kaktusResourceToModel(&ctx, data, agents)
+	_, _, err = r.Data.K.KaktusAPI.UpdateKaktus(ctx, data.ID.ValueString()).Kaktus(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, KaktusResourceName, data.Name.ValueString())
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -262,7 +270,7 @@ func (r *KaktusResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -275,7 +283,7 @@ func (r *KaktusResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	_, err := r.Data.K.KaktusAPI.DeleteKaktus(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, KaktusResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"strings"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -26,16 +30,21 @@ const (
 
 	KawaiiIPsecDefaultValueIngressProtocol = "tcp"
 	KawaiiIPsecDefaultValueIngressPolicy   = "accept"
+	KawaiiIPsecDefaultValueEgressProtocol  = "tcp"
 
 	KawaiiIPsecDefaultDpdTimeout    = "240s"
 	KawaiiIPsecDefaultDpdAction     = "restart"
 	KawaiiIPsecDefaultStartAction   = "start"
 	KawaiiIPsecDefaultRekeyTime     = "2h"
 	KawaiiIPsecDefaultPhaseLifetime = "1h"
+	KawaiiIPsecDefaultLocalSubnet   = ""
+	KawaiiIPsecDefaultRekeyMargin   = "9m"
+	KawaiiIPsecDefaultRekeyFuzz     = 100
 )
 
-var _ resource.Resource = &KawaiiResource{}
+var _ resource.Resource = &KawaiiIPsecConnectionResource{}
 var _ resource.ResourceWithImportState = &KawaiiIPsecConnectionResource{}
+var _ resource.ResourceWithValidateConfig = &KawaiiIPsecConnectionResource{}
 
 func NewKawaiiIPsecResource() resource.Resource {
 	return &KawaiiIPsecConnectionResource{}
@@ -56,10 +65,13 @@ type KawaiiIPsecConnectionResourceModel struct {
 	PreSharedKey              types.String `tfsdk:"pre_shared_key"`
 	RemotePeer                types.String `tfsdk:"remote_peer"`
 	RemoteSubnet              types.String `tfsdk:"remote_subnet"`
+	LocalSubnet               types.String `tfsdk:"local_subnet"`
 	DpdTimeout                types.String `tfsdk:"dpd_timeout"`
 	DpdTimeoutAction          types.String `tfsdk:"dpd_action"`
 	StartAction               types.String `tfsdk:"start_action"`
 	Rekey                     types.String `tfsdk:"rekey"`
+	RekeyMargin               types.String `tfsdk:"rekey_margin"`
+	RekeyFuzz                 types.Int64  `tfsdk:"rekey_fuzz"`
 	Phase1Lifetime            types.String `tfsdk:"phase1_lifetime"`
 	Phase1DHGroupNumber       types.Int64  `tfsdk:"phase1_dh_group_number"`
 	Phase1IntegrityAlgorithm  types.String `tfsdk:"phase1_integrity_algorithm"`
@@ -68,7 +80,25 @@ type KawaiiIPsecConnectionResourceModel struct {
 	Phase2DHGroupNumber       types.Int64  `tfsdk:"phase2_dh_group_number"`
 	Phase2IntegrityAlgorithm  types.String `tfsdk:"phase2_integrity_algorithm"`
 	Phase2EncryptionAlgorithm types.String `tfsdk:"phase2_encryption_algorithm"`
-	IngressRules              types.List   `tfsdk:"ingress_rules"` // KawaiiForwardRule
+	IngressRules              types.List   `tfsdk:"ingress_rules"`           // KawaiiIPsecIngressRule
+	EgressRules               types.List   `tfsdk:"egress_rules"`            // KawaiiIPsecEgressRule
+	EffectiveEgressPolicy     types.String `tfsdk:"effective_egress_policy"` // read-only
+	Status                    types.String `tfsdk:"status"`                  // read-only
+	LastEstablished           types.String `tfsdk:"last_established"`        // read-only
+}
+
+type KawaiiIPsecIngressRule struct {
+	Desc     types.String `tfsdk:"desc"`
+	Source   types.String `tfsdk:"source"`
+	Protocol types.String `tfsdk:"protocol"`
+	Ports    types.String `tfsdk:"ports"`
+}
+
+type KawaiiIPsecEgressRule struct {
+	Desc        types.String `tfsdk:"desc"`
+	Destination types.String `tfsdk:"destination"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Ports       types.String `tfsdk:"ports"`
 }
 
 func (r *KawaiiIPsecConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,6 +116,12 @@ func (r *KawaiiIPsecConnectionResource) Configure(ctx context.Context, req resou
 func (r *KawaiiIPsecConnectionResource) SchemaIngressRule() schema.NestedAttributeObject {
 	return schema.NestedAttributeObject{
 		Attributes: map[string]schema.Attribute{
+			KeyDesc: schema.StringAttribute{
+				MarkdownDescription: "The rule description, e.g. why traffic is allowed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiDefaultValueRuleDesc),
+			},
 			KeySource: schema.StringAttribute{
 				MarkdownDescription: "The source IP or CIDR to accept public traffic from (defaults to 0.0.0.0/0).",
 				Optional:            true,
@@ -96,16 +132,56 @@ func (r *KawaiiIPsecConnectionResource) SchemaIngressRule() schema.NestedAttribu
 				},
 			},
 			KeyProtocol: schema.StringAttribute{
-				MarkdownDescription: "The transport layer protocol to forward public traffic to (defaults to 'tcp')",
+				MarkdownDescription: "The transport layer protocol to accept traffic from remote peer: 'tcp' (default), 'udp' or 'icmp'.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(KawaiiIPsecDefaultValueIngressProtocol),
+				Validators: []validator.String{
+					&stringNetworkIngressProtocolValidator{},
+				},
+			},
+			KeyPorts: schema.StringAttribute{
+				MarkdownDescription: "The ports (or range of ports) allowed to receive traffic. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005). Required for 'tcp' and 'udp' protocols, must be left empty for 'icmp'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiDefaultValuePorts),
+				Validators: []validator.String{
+					&stringNetworkPortRangesValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *KawaiiIPsecConnectionResource) SchemaEgressRule() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			KeyDesc: schema.StringAttribute{
+				MarkdownDescription: "The rule description, e.g. why traffic is allowed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiDefaultValueRuleDesc),
+			},
+			KeyDestination: schema.StringAttribute{
+				MarkdownDescription: "The destination IP or CIDR to accept traffic to (defaults to 0.0.0.0/0).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiDefaultValueDestination),
+				Validators: []validator.String{
+					&stringNetworkAddressValidator{},
+				},
+			},
+			KeyProtocol: schema.StringAttribute{
+				MarkdownDescription: "The transport layer protocol to forward traffic to remote peer (defaults to 'tcp')",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiIPsecDefaultValueEgressProtocol),
 				Validators: []validator.String{
 					&stringNetworkProtocolValidator{},
 				},
 			},
 			KeyPorts: schema.StringAttribute{
-				MarkdownDescription: "The ports (or range of ports) allowed to receive traffic. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005).",
+				MarkdownDescription: "The ports (or range of ports) allowed to send traffic to. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005).",
 				Required:            true,
 				Validators: []validator.String{
 					&stringNetworkPortRangesValidator{},
@@ -126,6 +202,20 @@ func (r *KawaiiIPsecConnectionResource) Schema(ctx context.Context, req resource
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			KeyStatus: schema.StringAttribute{
+				MarkdownDescription: "The IPsec tunnel status, as last reported by Kawaii (read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyLastEstablished: schema.StringAttribute{
+				MarkdownDescription: "The date the IPsec tunnel was last established (read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			KeyKawaii: schema.StringAttribute{
 				MarkdownDescription: "Associated Kawaii name or ID",
 				Required:            true,
@@ -145,8 +235,12 @@ func (r *KawaiiIPsecConnectionResource) Schema(ctx context.Context, req resource
 				},
 			},
 			KeyPreSharedKey: schema.StringAttribute{
-				MarkdownDescription: "The Pre-Shared Key (PSK) to authenticate the VPN tunnel to your peer VPN gateway",
+				MarkdownDescription: "The Pre-Shared Key (PSK) to authenticate the VPN tunnel to your peer VPN gateway. The PSK is never read back from Kowabunga, remote changes won't be detected.",
 				Required:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			KeyRemoteSubnet: schema.StringAttribute{
 				MarkdownDescription: "Remote Subnet CIDR",
@@ -155,23 +249,41 @@ func (r *KawaiiIPsecConnectionResource) Schema(ctx context.Context, req resource
 					&stringNetworkAddressValidator{},
 				},
 			},
+			KeyLocalSubnet: schema.StringAttribute{
+				MarkdownDescription: "Local Subnet CIDR advertised through the tunnel. Defaults to the whole Kawaii private network.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiIPsecDefaultLocalSubnet),
+				Validators: []validator.String{
+					&stringNetworkAddressValidator{},
+				},
+			},
 			KeyIPsecDpdTimeout: schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf("Dead Peer Detection Timeout. Default is `%s`", KawaiiIPsecDefaultDpdTimeout),
+				MarkdownDescription: fmt.Sprintf("Dead Peer Detection Timeout. Use s, m, h and d suffixes. Default is `%s`", KawaiiIPsecDefaultDpdTimeout),
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(KawaiiIPsecDefaultDpdTimeout),
+				Validators: []validator.String{
+					&stringDurationValidator{},
+				},
 			},
 			KeyIPsecDpdAction: schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf("Dead Peer Detection Timeout Action. Default is `%s`", KawaiiIPsecDefaultDpdAction),
+				MarkdownDescription: fmt.Sprintf("Dead Peer Detection Timeout Action. Valid values are `%s`. Default is `%s`", strings.Join(dpdSupportedActions, " | "), KawaiiIPsecDefaultDpdAction),
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(KawaiiIPsecDefaultDpdAction),
+				Validators: []validator.String{
+					&stringDpdActionValidator{},
+				},
 			},
 			KeyIPsecStartAction: schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf("IPsec Default Start Action. Default is `%s`", KawaiiIPsecDefaultStartAction),
+				MarkdownDescription: fmt.Sprintf("IPsec Default Start Action. Valid values are `%s`. Default is `%s`", strings.Join(startSupportedActions, " | "), KawaiiIPsecDefaultStartAction),
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(KawaiiIPsecDefaultStartAction),
+				Validators: []validator.String{
+					&stringStartActionValidator{},
+				},
 			},
 			KeyIPsecRekeyTime: schema.StringAttribute{
 				MarkdownDescription: fmt.Sprintf("IPsec Rekey time in seconds. Default is `%s`", KawaiiIPsecDefaultRekeyTime),
@@ -182,6 +294,24 @@ func (r *KawaiiIPsecConnectionResource) Schema(ctx context.Context, req resource
 					&stringDurationValidator{},
 				},
 			},
+			KeyIPsecRekeyMargin: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("IPsec Child SA rekeying margin, i.e. how long before phase 2 lifetime expiry rekeying starts. Use s, m, h and d suffixes. Must be lower than phase 2 lifetime. Default is `%s`", KawaiiIPsecDefaultRekeyMargin),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiIPsecDefaultRekeyMargin),
+				Validators: []validator.String{
+					&stringDurationValidator{},
+				},
+			},
+			KeyIPsecRekeyFuzz: schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("IPsec Child SA rekeying fuzz, i.e. maximum percentage by which rekeying margin is randomly increased. Default is `%d`", KawaiiIPsecDefaultRekeyFuzz),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(KawaiiIPsecDefaultRekeyFuzz),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			KeyIPsecP1Lifetime: schema.StringAttribute{
 				MarkdownDescription: fmt.Sprintf("IPsec Phase 1 Lifetime. Use s, m, h and d suffixes. Default is `%s`", KawaiiIPsecDefaultPhaseLifetime),
 				Optional:            true,
@@ -192,21 +322,21 @@ func (r *KawaiiIPsecConnectionResource) Schema(ctx context.Context, req resource
 				},
 			},
 			KeyIPsecP1DHGroupNumber: schema.Int64Attribute{
-				MarkdownDescription: "IPsec phase 1 Diffie Hellman IANA Group Number. Valid values are `2 | 5 | 14 | 15 | 16 | 17 | 18 | 19 | 20 | 21 | 22 | 23 | 24`",
+				MarkdownDescription: fmt.Sprintf("IPsec phase 1 Diffie Hellman IANA Group Number. Valid values are `%s`", strings.Join(diffieHellmanSupportedGroups(), " | ")),
 				Required:            true,
 				Validators: []validator.Int64{
 					&diffieHellmanAlgorithmTypeValidator{},
 				},
 			},
 			KeyIPsecP1IntegrityAlgorithm: schema.StringAttribute{
-				MarkdownDescription: "IPsec phase 1 Integrity Algorithm. Valid values are `SHA1 | SHA2-256 | SHA2-384 | SHA2-512`",
+				MarkdownDescription: fmt.Sprintf("IPsec phase 1 Integrity Algorithm. Valid values are `%s`", strings.Join(integritySupportedTypes, " | ")),
 				Required:            true,
 				Validators: []validator.String{
 					&integrityAlgorithmTypeValidator{},
 				},
 			},
 			KeyIPsecP1EncryptionAlgorithm: schema.StringAttribute{
-				MarkdownDescription: "IPsec phase 1 Encryption Algorithm. Valid values are `AES128 | AES256 | CAMELLIA128 | CAMELLIA256`",
+				MarkdownDescription: fmt.Sprintf("IPsec phase 1 Encryption Algorithm. Valid values are `%s`", strings.Join(encryptionSupportedTypes, " | ")),
 				Required:            true,
 				Validators: []validator.String{
 					&encryptionAlgorithmTypeValidator{},
@@ -222,28 +352,28 @@ func (r *KawaiiIPsecConnectionResource) Schema(ctx context.Context, req resource
 				},
 			},
 			KeyIPsecP2DHGroupNumber: schema.Int64Attribute{
-				MarkdownDescription: "IPsec phase 2 Diffie Hellman IANA Group Number. Valid values are `2 | 5 | 14 | 15 | 16 | 17 | 18 | 19 | 20 | 21 | 22 | 23 | 24`",
+				MarkdownDescription: fmt.Sprintf("IPsec phase 2 Diffie Hellman IANA Group Number. Valid values are `%s`", strings.Join(diffieHellmanSupportedGroups(), " | ")),
 				Required:            true,
 				Validators: []validator.Int64{
 					&diffieHellmanAlgorithmTypeValidator{},
 				},
 			},
 			KeyIPsecP2IntegrityAlgorithm: schema.StringAttribute{
-				MarkdownDescription: "IPsec phase 1 Integrity Algorithm. Valid values are `SHA1 | SHA2-256 | SHA2-384 | SHA2-512`",
+				MarkdownDescription: fmt.Sprintf("IPsec phase 2 Integrity Algorithm. Valid values are `%s`", strings.Join(integritySupportedTypes, " | ")),
 				Required:            true,
 				Validators: []validator.String{
 					&integrityAlgorithmTypeValidator{},
 				},
 			},
 			KeyIPsecP2EncryptionAlgorithm: schema.StringAttribute{
-				MarkdownDescription: "IPsec phase 1 Encryption Algorithm. Valid values are `AES128 | AES256 | CAMELLIA128 | CAMELLIA256`",
+				MarkdownDescription: fmt.Sprintf("IPsec phase 2 Encryption Algorithm. Valid values are `%s`", strings.Join(encryptionSupportedTypes, " | ")),
 				Required:            true,
 				Validators: []validator.String{
 					&encryptionAlgorithmTypeValidator{},
 				},
 			},
 			KeyIngressRules: schema.ListNestedAttribute{
-				MarkdownDescription: "The firewall list of Ingress Rules. Default will accept all. Egress is allow all",
+				MarkdownDescription: "The firewall list of Ingress Rules. Default will accept all.",
 				Optional:            true,
 				Computed:            true,
 				NestedObject:        r.SchemaIngressRule(),
@@ -251,27 +381,112 @@ func (r *KawaiiIPsecConnectionResource) Schema(ctx context.Context, req resource
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			KeyEgressRules: schema.ListNestedAttribute{
+				MarkdownDescription: "The firewall list of Egress Rules, filtering traffic toward remote peer. Default will accept all.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject:        r.SchemaEgressRule(),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyEffectiveEgressPolicy: schema.StringAttribute{
+				MarkdownDescription: "The implicit policy applied to traffic toward remote peer: 'accept' when no egress rules are set (all traffic, including ICMP, is allowed), 'drop' otherwise (only egress rules traffic is allowed) (read-only)",
+				Computed:            true,
+			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
+func (r *KawaiiIPsecConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data KawaiiIPsecConnectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ICMP ingress rules are port-less, TCP/UDP ones require ports
+	ingressRules := make([]types.Object, 0, len(data.IngressRules.Elements()))
+	resp.Diagnostics.Append(data.IngressRules.ElementsAs(ctx, &ingressRules, false)...)
+	for idx, ir := range ingressRules {
+		rule := KawaiiIPsecIngressRule{}
+		diags := ir.As(ctx, &rule, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    true,
+			UnhandledUnknownAsEmpty: true,
+		})
+		if diags.HasError() || rule.Protocol.IsUnknown() || rule.Ports.IsUnknown() {
+			continue
+		}
+
+		p := path.Root(KeyIngressRules).AtListIndex(idx).AtName(KeyPorts)
+		protocol := KawaiiIPsecDefaultValueIngressProtocol
+		if !rule.Protocol.IsNull() {
+			protocol = strings.ToLower(rule.Protocol.ValueString())
+		}
+		icmp := protocol == KawaiiProtocolICMP
+		if icmp && rule.Ports.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(p, ErrorInvalidFirewallRule,
+				fmt.Sprintf("%s: ports must be left empty for 'icmp' protocol", ErrorInvalidFirewallRule))
+		}
+		if !icmp && rule.Ports.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(p, ErrorInvalidFirewallRule,
+				fmt.Sprintf("%s: ports are required for '%s' protocol", ErrorInvalidFirewallRule, protocol))
+		}
+	}
+
+	if data.RekeyMargin.IsUnknown() || data.Phase2Lifetime.IsUnknown() {
+		return
+	}
+
+	// Child SA rekeying must start before phase 2 lifetime expires
+	margin := KawaiiIPsecDefaultRekeyMargin
+	if !data.RekeyMargin.IsNull() {
+		margin = data.RekeyMargin.ValueString()
+	}
+	lifetime := KawaiiIPsecDefaultPhaseLifetime
+	if !data.Phase2Lifetime.IsNull() {
+		lifetime = data.Phase2Lifetime.ValueString()
+	}
+	marginSeconds, err := durationSeconds(margin)
+	if err != nil {
+		return
+	}
+	lifetimeSeconds, err := durationSeconds(lifetime)
+	if err != nil {
+		return
+	}
+	if marginSeconds >= lifetimeSeconds {
+		resp.Diagnostics.AddAttributeError(path.Root(KeyIPsecRekeyMargin), ErrorInvalidRekeyMargin,
+			fmt.Sprintf("%s: %s must be lower than phase 2 lifetime (%s)", ErrorInvalidRekeyMargin, margin, lifetime))
+	}
+}
+
 // ////////////////////////////////////////////////////////////////////
 // converts kawaii Ipsec from Terraform model to Kowabunga API model //
 // ////////////////////////////////////////////////////////////////////
 func kawaiiIPsecResourceModel(ctx *context.Context, d *KawaiiIPsecConnectionResourceModel) sdk.KawaiiIpSec {
 
+	// whole Kawaii private network is advertised by default
+	var localSubnet *string
+	if d.LocalSubnet.ValueString() != KawaiiIPsecDefaultLocalSubnet {
+		localSubnet = d.LocalSubnet.ValueStringPointer()
+	}
+
 	return sdk.KawaiiIpSec{
 		Name:                      d.Name.ValueString(),
 		Ip:                        d.IP.ValueStringPointer(),
 		Description:               d.Desc.ValueStringPointer(),
 		RemoteIp:                  d.RemotePeer.ValueString(),
 		RemoteSubnet:              d.RemoteSubnet.ValueString(),
+		LocalSubnet:               localSubnet,
 		PreSharedKey:              d.PreSharedKey.ValueString(),
 		DpdTimeoutAction:          d.DpdTimeoutAction.ValueStringPointer(),
 		DpdTimeout:                d.DpdTimeout.ValueStringPointer(),
 		StartAction:               d.StartAction.ValueStringPointer(),
 		RekeyTime:                 d.Rekey.ValueStringPointer(),
+		RekeyMargin:               d.RekeyMargin.ValueStringPointer(),
+		RekeyFuzz:                 d.RekeyFuzz.ValueInt64Pointer(),
 		Phase1Lifetime:            d.Phase1Lifetime.ValueStringPointer(),
 		Phase1DhGroupNumber:       d.Phase1DHGroupNumber.ValueInt64(),
 		Phase1IntegrityAlgorithm:  d.Phase1IntegrityAlgorithm.ValueString(),
@@ -287,6 +502,7 @@ func kawaiiIPsecResourceModel(ctx *context.Context, d *KawaiiIPsecConnectionReso
 func kawaiiIPsecFirewallModel(ctx *context.Context, d *KawaiiIPsecConnectionResourceModel) sdk.KawaiiFirewall {
 	fwModel := sdk.KawaiiFirewall{
 		Ingress: []sdk.KawaiiFirewallIngressRule{},
+		Egress:  []sdk.KawaiiFirewallEgressRule{},
 	}
 
 	// Ingress Rules
@@ -298,7 +514,7 @@ func kawaiiIPsecFirewallModel(ctx *context.Context, d *KawaiiIPsecConnectionReso
 		}
 	}
 	for _, ir := range ingressRules {
-		rule := KawaiiIngressRule{}
+		rule := KawaiiIPsecIngressRule{}
 		diags := ir.As(*ctx, &rule, basetypes.ObjectAsOptions{
 			UnhandledNullAsEmpty:    true,
 			UnhandledUnknownAsEmpty: true,
@@ -309,12 +525,48 @@ func kawaiiIPsecFirewallModel(ctx *context.Context, d *KawaiiIPsecConnectionReso
 			}
 		}
 
+		// ICMP is port-less, accepting all traffic of its kind
+		ports := networkPortsCanonical(rule.Ports.ValueString())
+		if strings.ToLower(rule.Protocol.ValueString()) == KawaiiProtocolICMP {
+			ports = KawaiiDefaultValuePorts
+		}
+
 		fwModel.Ingress = append(fwModel.Ingress, sdk.KawaiiFirewallIngressRule{
-			Source:   rule.Source.ValueStringPointer(),
-			Protocol: rule.Protocol.ValueStringPointer(),
-			Ports:    rule.Ports.ValueString(),
+			Description: rule.Desc.ValueStringPointer(),
+			Source:      rule.Source.ValueStringPointer(),
+			Protocol:    rule.Protocol.ValueStringPointer(),
+			Ports:       ports,
 		})
 	}
+
+	// Egress Rules
+	egressRules := make([]types.Object, 0, len(d.EgressRules.Elements()))
+	egressDiags := d.EgressRules.ElementsAs(*ctx, &egressRules, false)
+	if egressDiags.HasError() {
+		for _, err := range egressDiags.Errors() {
+			tflog.Debug(*ctx, err.Detail())
+		}
+	}
+	for _, er := range egressRules {
+		rule := KawaiiIPsecEgressRule{}
+		diags := er.As(*ctx, &rule, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    true,
+			UnhandledUnknownAsEmpty: true,
+		})
+		if diags.HasError() {
+			for _, err := range diags.Errors() {
+				tflog.Error(*ctx, err.Detail())
+			}
+		}
+
+		fwModel.Egress = append(fwModel.Egress, sdk.KawaiiFirewallEgressRule{
+			Description: rule.Desc.ValueStringPointer(),
+			Destination: rule.Destination.ValueStringPointer(),
+			Protocol:    rule.Protocol.ValueStringPointer(),
+			Ports:       networkPortsCanonical(rule.Ports.ValueString()),
+		})
+	}
+
 	return fwModel
 }
 
@@ -326,11 +578,13 @@ func kawaiiIPsecModelToIngressRules(ctx *context.Context, r *sdk.KawaiiIpSec, d
 	// ingress rules
 	ingressRules := []attr.Value{}
 	ingressRuleType := map[string]attr.Type{
+		KeyDesc:     types.StringType,
 		KeySource:   types.StringType,
 		KeyProtocol: types.StringType,
 		KeyPorts:    types.StringType,
 	}
-	for _, ir := range r.Firewall.Ingress {
+	ingressPorts := kawaiiRulesPorts(d.IngressRules.Elements())
+	for idx, ir := range r.Firewall.Ingress {
 		source := KawaiiDefaultValueSource
 		if ir.Source != nil {
 			source = *ir.Source
@@ -340,9 +594,10 @@ func kawaiiIPsecModelToIngressRules(ctx *context.Context, r *sdk.KawaiiIpSec, d
 			protocol = *ir.Protocol
 		}
 		r := map[string]attr.Value{
+			KeyDesc:     types.StringValue(kawaiiRuleDesc(ir.Description)),
 			KeySource:   types.StringValue(source),
 			KeyProtocol: types.StringValue(protocol),
-			KeyPorts:    types.StringValue(ir.Ports),
+			KeyPorts:    types.StringValue(kawaiiRulePorts(ingressPorts, idx, ir.Ports)),
 		}
 		object, _ := types.ObjectValue(ingressRuleType, r)
 		ingressRules = append(ingressRules, object)
@@ -356,6 +611,51 @@ func kawaiiIPsecModelToIngressRules(ctx *context.Context, r *sdk.KawaiiIpSec, d
 	}
 }
 
+func kawaiiIPsecModelToEgressRules(ctx *context.Context, r *sdk.KawaiiIpSec, d *KawaiiIPsecConnectionResourceModel) {
+	// egress rules
+	egressRules := []attr.Value{}
+	egressRuleType := map[string]attr.Type{
+		KeyDesc:        types.StringType,
+		KeyDestination: types.StringType,
+		KeyProtocol:    types.StringType,
+		KeyPorts:       types.StringType,
+	}
+	egressPorts := kawaiiRulesPorts(d.EgressRules.Elements())
+	for idx, er := range r.Firewall.Egress {
+		destination := KawaiiDefaultValueDestination
+		if er.Destination != nil {
+			destination = *er.Destination
+		}
+		protocol := KawaiiIPsecDefaultValueEgressProtocol
+		if er.Protocol != nil {
+			protocol = *er.Protocol
+		}
+		r := map[string]attr.Value{
+			KeyDesc:        types.StringValue(kawaiiRuleDesc(er.Description)),
+			KeyDestination: types.StringValue(destination),
+			KeyProtocol:    types.StringValue(protocol),
+			KeyPorts:       types.StringValue(kawaiiRulePorts(egressPorts, idx, er.Ports)),
+		}
+		object, _ := types.ObjectValue(egressRuleType, r)
+		egressRules = append(egressRules, object)
+	}
+
+	if len(r.Firewall.Egress) == 0 {
+		d.EgressRules = types.ListNull(types.ObjectType{AttrTypes: egressRuleType})
+	} else {
+		d.EgressRules, _ = types.ListValue(types.ObjectType{AttrTypes: egressRuleType}, egressRules)
+	}
+	d.EffectiveEgressPolicy = kawaiiIPsecEffectiveEgressPolicy(len(r.Firewall.Egress))
+}
+
+// traffic toward remote peer is implicitly accepted, unless restricted by egress rules
+func kawaiiIPsecEffectiveEgressPolicy(egressRules int) types.String {
+	if egressRules == 0 {
+		return types.StringValue(KawaiiPolicyAccept)
+	}
+	return types.StringValue(KawaiiPolicyDrop)
+}
+
 func kawaiiIPsecModelToResource(ctx *context.Context, r *sdk.KawaiiIpSec, d *KawaiiIPsecConnectionResourceModel) {
 	if r == nil {
 		return
@@ -367,22 +667,33 @@ func kawaiiIPsecModelToResource(ctx *context.Context, r *sdk.KawaiiIpSec, d *Kaw
 		d.IP = types.StringValue("")
 	}
 	d.RemotePeer = types.StringValue(r.RemoteIp)
+	if r.Status != nil {
+		d.Status = types.StringPointerValue(r.Status)
+	} else {
+		d.Status = types.StringValue("")
+	}
+	if r.LastEstablished != nil {
+		d.LastEstablished = types.StringPointerValue(r.LastEstablished)
+	} else {
+		d.LastEstablished = types.StringValue("")
+	}
 	d.RemoteSubnet = types.StringValue(r.RemoteSubnet)
-	d.PreSharedKey = types.StringValue(r.PreSharedKey)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
+	if r.LocalSubnet != nil {
+		d.LocalSubnet = types.StringPointerValue(r.LocalSubnet)
 	} else {
-		d.Desc = types.StringValue("")
+		d.LocalSubnet = types.StringValue(KawaiiIPsecDefaultLocalSubnet)
 	}
+	// PSK is write-only, never read it back
+	d.Desc = descModelToResource(r.Description)
 	if r.DpdTimeoutAction != nil {
 		d.DpdTimeoutAction = types.StringPointerValue(r.DpdTimeoutAction)
 	} else {
-		d.DpdTimeoutAction = types.StringValue(KawaiiIPsecDefaultDpdTimeout)
+		d.DpdTimeoutAction = types.StringValue(KawaiiIPsecDefaultDpdAction)
 	}
 	if r.DpdTimeout != nil {
 		d.DpdTimeout = types.StringPointerValue(r.DpdTimeout)
 	} else {
-		d.DpdTimeout = types.StringValue(KawaiiIPsecDefaultDpdAction)
+		d.DpdTimeout = types.StringValue(KawaiiIPsecDefaultDpdTimeout)
 	}
 	if r.StartAction != nil {
 		d.StartAction = types.StringPointerValue(r.StartAction)
@@ -394,6 +705,16 @@ func kawaiiIPsecModelToResource(ctx *context.Context, r *sdk.KawaiiIpSec, d *Kaw
 	} else {
 		d.Rekey = types.StringValue(KawaiiIPsecDefaultRekeyTime)
 	}
+	if r.RekeyMargin != nil {
+		d.RekeyMargin = types.StringPointerValue(r.RekeyMargin)
+	} else {
+		d.RekeyMargin = types.StringValue(KawaiiIPsecDefaultRekeyMargin)
+	}
+	if r.RekeyFuzz != nil {
+		d.RekeyFuzz = types.Int64PointerValue(r.RekeyFuzz)
+	} else {
+		d.RekeyFuzz = types.Int64Value(KawaiiIPsecDefaultRekeyFuzz)
+	}
 	if r.Phase1Lifetime != nil {
 		d.Phase1Lifetime = types.StringPointerValue(r.Phase1Lifetime)
 	} else {
@@ -411,6 +732,7 @@ func kawaiiIPsecModelToResource(ctx *context.Context, r *sdk.KawaiiIpSec, d *Kaw
 	d.Phase2IntegrityAlgorithm = types.StringValue(r.Phase2IntegrityAlgorithm)
 	d.Phase2EncryptionAlgorithm = types.StringValue(r.Phase2EncryptionAlgorithm)
 	kawaiiIPsecModelToIngressRules(ctx, r, d)
+	kawaiiIPsecModelToEgressRules(ctx, r, d)
 }
 
 //////////////////////////////
@@ -424,8 +746,9 @@ func (r *KawaiiIPsecConnectionResource) Create(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.PreSharedKey)
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -436,17 +759,17 @@ func (r *KawaiiIPsecConnectionResource) Create(ctx context.Context, req resource
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	// find parent project
+	// find parent kawaii
 	kawaiiId, err := getKawaiiID(ctx, r.Data, data.KawaiiID.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KawaiiIPsecResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new Kawaii IPsec Connection
 	m := kawaiiIPsecResourceModel(&ctx, data)
 	kawaiiIpSec, _, err := r.Data.K.KawaiiAPI.CreateKawaiiIpSec(ctx, kawaiiId).KawaiiIpSec(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KawaiiIPsecResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(kawaiiIpSec.Id)
@@ -461,8 +784,9 @@ func (r *KawaiiIPsecConnectionResource) Read(ctx context.Context, req resource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.PreSharedKey)
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -473,9 +797,15 @@ func (r *KawaiiIPsecConnectionResource) Read(ctx context.Context, req resource.R
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	kawaiiIpSec, _, err := r.Data.K.KawaiiAPI.ReadKawaiiIpSec(ctx, data.KawaiiID.ValueString(), data.ID.ValueString()).Execute()
+	// find parent kawaii
+	kawaiiId, err := getKawaiiID(ctx, r.Data, data.KawaiiID.ValueString())
+	if err != nil {
+		errorReadGeneric(resp, err, KawaiiIPsecResourceName, data.Name.ValueString())
+		return
+	}
+	kawaiiIpSec, _, err := r.Data.K.KawaiiAPI.ReadKawaiiIpSec(ctx, kawaiiId, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, KawaiiIPsecResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -489,8 +819,9 @@ func (r *KawaiiIPsecConnectionResource) Update(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.PreSharedKey)
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -501,13 +832,20 @@ func (r *KawaiiIPsecConnectionResource) Update(ctx context.Context, req resource
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
+	// find parent kawaii
+	kawaiiId, err := getKawaiiID(ctx, r.Data, data.KawaiiID.ValueString())
+	if err != nil {
+		errorUpdateGeneric(resp, err, KawaiiIPsecResourceName, data.Name.ValueString())
+		return
+	}
 	m := kawaiiIPsecResourceModel(&ctx, data)
-	_, _, err := r.Data.K.KawaiiAPI.UpdateKawaiiIpSec(ctx, data.KawaiiID.ValueString(), data.ID.ValueString()).KawaiiIpSec(m).Execute()
+	_, _, err = r.Data.K.KawaiiAPI.UpdateKawaiiIpSec(ctx, kawaiiId, data.ID.ValueString()).KawaiiIpSec(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, KawaiiIPsecResourceName, data.Name.ValueString())
 		return
 	}
 
+	data.EffectiveEgressPolicy = kawaiiIPsecEffectiveEgressPolicy(len(data.EgressRules.Elements()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -517,8 +855,9 @@ func (r *KawaiiIPsecConnectionResource) Delete(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.PreSharedKey)
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -529,9 +868,15 @@ func (r *KawaiiIPsecConnectionResource) Delete(ctx context.Context, req resource
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	_, err := r.Data.K.KawaiiAPI.DeleteKawaiiIpSec(ctx, data.KawaiiID.ValueString(), data.ID.ValueString()).Execute()
+	// find parent kawaii
+	kawaiiId, err := getKawaiiID(ctx, r.Data, data.KawaiiID.ValueString())
+	if err != nil {
+		errorDeleteGeneric(resp, err, KawaiiIPsecResourceName, data.Name.ValueString())
+		return
+	}
+	_, err = r.Data.K.KawaiiAPI.DeleteKawaiiIpSec(ctx, kawaiiId, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, KawaiiIPsecResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
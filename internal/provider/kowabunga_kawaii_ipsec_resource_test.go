@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewKawaiiIPsecResource(t *testing.T) {
+	r := NewKawaiiIPsecResource()
+
+	if _, ok := r.(*KawaiiIPsecConnectionResource); !ok {
+		t.Fatalf("NewKawaiiIPsecResource() returned %T, want *KawaiiIPsecConnectionResource", r)
+	}
+	if _, ok := r.(resource.ResourceWithImportState); !ok {
+		t.Errorf("%T does not implement resource.ResourceWithImportState", r)
+	}
+	if _, ok := r.(resource.ResourceWithValidateConfig); !ok {
+		t.Errorf("%T does not implement resource.ResourceWithValidateConfig", r)
+	}
+}
+
+func TestKawaiiIPsecFirewallModelIngressICMP(t *testing.T) {
+	ctx := context.Background()
+	ruleType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		KeyDesc:     types.StringType,
+		KeySource:   types.StringType,
+		KeyProtocol: types.StringType,
+		KeyPorts:    types.StringType,
+	}}
+	ingress, diags := types.ListValueFrom(ctx, ruleType, []KawaiiIPsecIngressRule{
+		{
+			Desc:     types.StringValue("ping"),
+			Source:   types.StringValue("10.0.0.0/8"),
+			Protocol: types.StringValue("icmp"),
+			Ports:    types.StringNull(),
+		},
+		{
+			Desc:     types.StringValue("web"),
+			Source:   types.StringValue("10.0.0.0/8"),
+			Protocol: types.StringValue("tcp"),
+			Ports:    types.StringValue("443,80"),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	d := &KawaiiIPsecConnectionResourceModel{
+		IngressRules: ingress,
+		EgressRules:  types.ListNull(ruleType),
+	}
+	fw := kawaiiIPsecFirewallModel(&ctx, d)
+
+	if len(fw.Ingress) != 2 {
+		t.Fatalf("got %d ingress rules, want 2", len(fw.Ingress))
+	}
+	if fw.Ingress[0].Protocol == nil || *fw.Ingress[0].Protocol != KawaiiProtocolICMP {
+		t.Errorf("ICMP rule protocol = %v, want %q", fw.Ingress[0].Protocol, KawaiiProtocolICMP)
+	}
+	if fw.Ingress[0].Ports != "" {
+		t.Errorf("ICMP rule ports = %q, want none", fw.Ingress[0].Ports)
+	}
+	if fw.Ingress[1].Ports != "80,443" {
+		t.Errorf("TCP rule ports = %q, want %q", fw.Ingress[1].Ports, "80,443")
+	}
+	if len(fw.Egress) != 0 {
+		t.Errorf("got %d egress rules, want none", len(fw.Egress))
+	}
+}
+
+func TestKawaiiIPsecEffectiveEgressPolicy(t *testing.T) {
+	tests := []struct {
+		egressRules int
+		want        string
+	}{
+		{egressRules: 0, want: KawaiiPolicyAccept},
+		{egressRules: 1, want: KawaiiPolicyDrop},
+		{egressRules: 3, want: KawaiiPolicyDrop},
+	}
+
+	for _, tt := range tests {
+		if got := kawaiiIPsecEffectiveEgressPolicy(tt.egressRules); got.ValueString() != tt.want {
+			t.Errorf("kawaiiIPsecEffectiveEgressPolicy(%d) = %s, want %q", tt.egressRules, got, tt.want)
+		}
+	}
+}
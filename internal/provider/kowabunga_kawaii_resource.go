@@ -1,15 +1,27 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
 	"maps"
+	"net/netip"
+	"slices"
+	"strings"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,15 +37,29 @@ const (
 	KawaiiResourceName = "kawaii"
 
 	KawaiiDefaultValueProtocol      = "tcp"
+	KawaiiDefaultValuePorts         = ""
+	KawaiiDefaultValuePriority      = 0
+	KawaiiDefaultValueLog           = false
+	KawaiiDefaultValueStateful      = true
+	KawaiiProtocolICMP              = "icmp"
 	KawaiiDefaultValueIngressPolicy = "drop"
 	KawaiiDefaultValueEgressPolicy  = "accept"
 	KawaiiDefaultValueForwardPolicy = "drop"
+	KawaiiPolicyAccept              = "accept"
+	KawaiiPolicyDrop                = "drop"
 	KawaiiDefaultValueSource        = "0.0.0.0/0"
 	KawaiiDefaultValueDestination   = "0.0.0.0/0"
+	KawaiiDefaultValuePeeringCIDR   = ""
+	KawaiiDefaultValueHealthCheck   = "10s"
+	KawaiiDefaultValueRateLimit     = ""
+	KawaiiDefaultValueRuleDesc      = ""
+	KawaiiDefaultValueValidateRules = true
 )
 
 var _ resource.Resource = &KawaiiResource{}
 var _ resource.ResourceWithImportState = &KawaiiResource{}
+var _ resource.ResourceWithValidateConfig = &KawaiiResource{}
+var _ resource.ResourceWithModifyPlan = &KawaiiResource{}
 
 func NewKawaiiResource() resource.Resource {
 	return &KawaiiResource{}
@@ -49,13 +75,18 @@ type KawaiiResourceModel struct {
 	Desc     types.String   `tfsdk:"desc"`
 	Project  types.String   `tfsdk:"project"`
 	Region   types.String   `tfsdk:"region"`
-
-	NetworkCfg   types.Object `tfsdk:"netcfg"`        // read-only
-	IngressRules types.List   `tfsdk:"ingress_rules"` // KawaiiIngressRule
-	EgressPolicy types.String `tfsdk:"egress_policy"`
-	EgressRules  types.List   `tfsdk:"egress_rules"` // KawaiiEgressRule
-	NatRules     types.List   `tfsdk:"nat_rules"`    // KawaiiNatRule
-	VpcPeerings  types.List   `tfsdk:"vpc_peerings"` // KawaiiVpcPeering
+	Zones    types.List     `tfsdk:"zones"` // []string
+
+	NetworkCfg     types.Object `tfsdk:"netcfg"`        // read-only
+	NetworkCfgJSON types.String `tfsdk:"netcfg_json"`   // read-only
+	IngressRules   types.Set    `tfsdk:"ingress_rules"` // KawaiiIngressRule
+	EgressPolicy   types.String `tfsdk:"egress_policy"`
+	LogPolicy      types.Bool   `tfsdk:"log_policy"`
+	EgressRules    types.Set    `tfsdk:"egress_rules"` // KawaiiEgressRule
+	NatRules       types.Set    `tfsdk:"nat_rules"`    // KawaiiNatRule
+	VpcPeerings    types.List   `tfsdk:"vpc_peerings"` // KawaiiVpcPeering
+	ValidateRules  types.Bool   `tfsdk:"validate_rules"`
+	RulesetHash    types.String `tfsdk:"ruleset_hash"` // read-only
 }
 
 type KawaiiNetworkConfig struct {
@@ -71,26 +102,50 @@ type KawaiiNetworkZoneConfig struct {
 }
 
 type KawaiiIngressRule struct {
-	Source   types.String `tfsdk:"source"`
-	Protocol types.String `tfsdk:"protocol"`
-	Ports    types.String `tfsdk:"ports"`
+	Desc      types.String `tfsdk:"desc"`
+	Source    types.String `tfsdk:"source"`
+	Protocol  types.String `tfsdk:"protocol"`
+	Ports     types.String `tfsdk:"ports"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Log       types.Bool   `tfsdk:"log"`
+	RateLimit types.String `tfsdk:"rate_limit"`
+	Stateful  types.Bool   `tfsdk:"stateful"`
+	Stats     types.Object `tfsdk:"stats"` // read-only
 }
 
 type KawaiiEgressRule struct {
+	Desc        types.String `tfsdk:"desc"`
+	Action      types.String `tfsdk:"action"`
 	Destination types.String `tfsdk:"destination"`
 	Protocol    types.String `tfsdk:"protocol"`
 	Ports       types.String `tfsdk:"ports"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Log         types.Bool   `tfsdk:"log"`
+	Stateful    types.Bool   `tfsdk:"stateful"`
+	Stats       types.Object `tfsdk:"stats"` // read-only
 }
 
 type KawaiiForwardRule struct {
-	Protocol types.String `tfsdk:"protocol"`
-	Ports    types.String `tfsdk:"ports"`
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Ports       types.String `tfsdk:"ports"`
 }
 
 type KawaiiNatRule struct {
+	Desc        types.String `tfsdk:"desc"`
+	Source      types.String `tfsdk:"source"`
 	Destination types.String `tfsdk:"destination"`
 	Protocol    types.String `tfsdk:"protocol"`
 	Ports       types.String `tfsdk:"ports"`
+	HealthCheck types.Object `tfsdk:"health_check"` // KawaiiNatHealthCheck
+	Stats       types.Object `tfsdk:"stats"`        // read-only
+}
+
+type KawaiiNatHealthCheck struct {
+	Port     types.Int64  `tfsdk:"port"`
+	Interval types.String `tfsdk:"interval"`
+	Protocol types.String `tfsdk:"protocol"`
 }
 
 type KawaiiVpcPeering struct {
@@ -106,12 +161,17 @@ type KawaiiVpcPeeringNetworkZoneConfig struct {
 	PrivateIp types.String `tfsdk:"private_ip"`
 }
 
+// Kawaii is unnamed, identified by its parent project and region
+func kawaiiDisplayName(d *KawaiiResourceModel) string {
+	return d.Project.ValueString() + "/" + d.Region.ValueString()
+}
+
 func (r *KawaiiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resourceMetadata(req, resp, KawaiiResourceName)
 }
 
 func (r *KawaiiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateComposite(ctx, req, resp, KeyProject, KeyRegion)
 }
 
 func (r *KawaiiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -187,50 +247,119 @@ func (r *KawaiiResource) SchemaNetworkConfig() schema.SingleNestedAttribute {
 	}
 }
 
-func (r *KawaiiResource) SchemaIngressRules() schema.ListNestedAttribute {
-	return schema.ListNestedAttribute{
-		MarkdownDescription: "The Kawaii public firewall list of ingress rules. Kawaii default policy is to drop all incoming traffic, including ICMP. Specified ruleset will be explicitly accepted.",
+func (r *KawaiiResource) SchemaRuleDesc() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The rule description, e.g. why traffic is allowed.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(KawaiiDefaultValueRuleDesc),
+	}
+}
+
+// live counters, refreshed by any read or update, and thus never carried over from prior state
+func (r *KawaiiResource) SchemaRuleStats() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The rule hit counters (read-only)",
+		Required:            false,
+		Optional:            false,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			KeyPackets: schema.Int64Attribute{
+				MarkdownDescription: "Number of packets matching the rule (read-only)",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+			KeyBytes: schema.Int64Attribute{
+				MarkdownDescription: "Number of bytes matching the rule (read-only)",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *KawaiiResource) SchemaIngressRules() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "The Kawaii public firewall set of ingress rules. Kawaii default policy is to drop all incoming traffic, including ICMP. Specified ruleset will be explicitly accepted. Rules are unordered, reordering them does not trigger any change.",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
 				KeySource: schema.StringAttribute{
-					MarkdownDescription: "The source IP or CIDR to accept public traffic from (defaults to 0.0.0.0/0).",
+					MarkdownDescription: "The source IPv4/IPv6 address or CIDR to accept public traffic from (defaults to 0.0.0.0/0, use ::/0 for any IPv6 source). A Kowabunga subnet name or ID can be specified instead, in which case it is resolved to the subnet's CIDR.",
 					Optional:            true,
 					Computed:            true,
 					Default:             stringdefault.StaticString(KawaiiDefaultValueSource),
-					Validators: []validator.String{
-						&stringNetworkAddressValidator{},
-					},
 				},
 				KeyProtocol: schema.StringAttribute{
-					MarkdownDescription: "The transport layer protocol to accept public traffic from (defaults to 'tcp').",
+					MarkdownDescription: "The transport layer protocol to accept public traffic from: 'tcp' (default), 'udp' or 'icmp'.",
 					Optional:            true,
 					Computed:            true,
 					Default:             stringdefault.StaticString(KawaiiDefaultValueProtocol),
 					Validators: []validator.String{
-						&stringNetworkProtocolValidator{},
+						&stringNetworkIngressProtocolValidator{},
 					},
 				},
 				KeyPorts: schema.StringAttribute{
-					MarkdownDescription: "The port (or list of ports) to accept public traffic from. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005).",
-					Required:            true,
+					MarkdownDescription: "The port (or list of ports) to accept public traffic from. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005). Well-known service names (e.g. ssh, https) are accepted. Required for 'tcp' and 'udp' protocols, must be left empty for 'icmp'.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(KawaiiDefaultValuePorts),
 					Validators: []validator.String{
 						&stringNetworkPortRangesValidator{},
 					},
 				},
+				KeyPriority: schema.Int64Attribute{
+					MarkdownDescription: "The rule evaluation priority. Rules are evaluated in ascending priority order, rules of equal priority being evaluated in canonical protocol, ports and source order (defaults to 0).",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(KawaiiDefaultValuePriority),
+				},
+				KeyRateLimit: schema.StringAttribute{
+					MarkdownDescription: "The maximum rate of accepted packets, expressed per 'second', 'minute', 'hour' or 'day' (e.g. 10/second). Unlimited by default.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(KawaiiDefaultValueRateLimit),
+					Validators: []validator.String{
+						&stringRateLimitValidator{},
+					},
+				},
+				KeyLog: schema.BoolAttribute{
+					MarkdownDescription: "Whether to log packets matching this rule (default: **false**).",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(KawaiiDefaultValueLog),
+				},
+				KeyStateful: schema.BoolAttribute{
+					MarkdownDescription: "Whether the rule relies on connection tracking, automatically accepting related and established return traffic (default: **true**). Stateless rules must be explicitly declared in both directions.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(KawaiiDefaultValueStateful),
+				},
+				KeyDesc:  r.SchemaRuleDesc(),
+				KeyStats: r.SchemaRuleStats(),
 			},
 		},
 	}
 }
 
-func (r *KawaiiResource) SchemaEgressRules() schema.ListNestedAttribute {
-	return schema.ListNestedAttribute{
-		MarkdownDescription: "Kawaii public firewall list of egress rules. Kawaii default policy is to accept all outgoing traffic, including ICMP. Specified ruleset will be explicitly dropped if egress_policy is set to accept, and explicitly accepted if egress policy is set to drop.",
+func (r *KawaiiResource) SchemaEgressRules() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "Kawaii public firewall set of egress rules. Kawaii default policy is to accept all outgoing traffic, including ICMP. Specified ruleset will be explicitly dropped if egress_policy is set to accept, and explicitly accepted if egress policy is set to drop, unless overridden by the rule explicit action. Rules are unordered, reordering them does not trigger any change: use priorities to enforce the evaluation order of conflicting rules.",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
+				KeyAction: schema.StringAttribute{
+					MarkdownDescription: "The rule explicit action: 'accept' or 'drop' (defaults to the inverse of egress_policy)",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.String{
+						&stringFirewallPolicyValidator{},
+					},
+				},
 				KeyDestination: schema.StringAttribute{
-					MarkdownDescription: "The destination IP or CIDR to accept/drop public traffic to (defaults to 0.0.0.0/0) ",
+					MarkdownDescription: "The destination IPv4/IPv6 address or CIDR to accept/drop public traffic to (defaults to 0.0.0.0/0, use ::/0 for any IPv6 destination) ",
 					Optional:            true,
 					Computed:            true,
 					Default:             stringdefault.StaticString(KawaiiDefaultValueDestination),
@@ -248,12 +377,32 @@ func (r *KawaiiResource) SchemaEgressRules() schema.ListNestedAttribute {
 					},
 				},
 				KeyPorts: schema.StringAttribute{
-					MarkdownDescription: "The port (or list of ports) to forward public traffic from. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005).",
+					MarkdownDescription: "The port (or list of ports) to forward public traffic from. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005). Well-known service names (e.g. ssh, https) are accepted.",
 					Required:            true,
 					Validators: []validator.String{
 						&stringNetworkPortRangesValidator{},
 					},
 				},
+				KeyPriority: schema.Int64Attribute{
+					MarkdownDescription: "The rule evaluation priority. Rules are evaluated in ascending priority order, rules of equal priority being evaluated in canonical protocol, ports and destination order (defaults to 0).",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(KawaiiDefaultValuePriority),
+				},
+				KeyLog: schema.BoolAttribute{
+					MarkdownDescription: "Whether to log packets matching this rule (default: **false**).",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(KawaiiDefaultValueLog),
+				},
+				KeyStateful: schema.BoolAttribute{
+					MarkdownDescription: "Whether the rule relies on connection tracking, automatically accepting related and established return traffic (default: **true**). Stateless rules must be explicitly declared in both directions.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(KawaiiDefaultValueStateful),
+				},
+				KeyDesc:  r.SchemaRuleDesc(),
+				KeyStats: r.SchemaRuleStats(),
 			},
 		},
 	}
@@ -262,6 +411,24 @@ func (r *KawaiiResource) SchemaEgressRules() schema.ListNestedAttribute {
 func (r *KawaiiResource) SchemaForwardRule() schema.NestedAttributeObject {
 	return schema.NestedAttributeObject{
 		Attributes: map[string]schema.Attribute{
+			KeySource: schema.StringAttribute{
+				MarkdownDescription: "The source IP or CIDR to restrict forwarded traffic from (defaults to any)",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiDefaultValuePeeringCIDR),
+				Validators: []validator.String{
+					&stringNetworkAddressValidator{},
+				},
+			},
+			KeyDestination: schema.StringAttribute{
+				MarkdownDescription: "The destination IP or CIDR to restrict forwarded traffic to (defaults to any)",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiDefaultValuePeeringCIDR),
+				Validators: []validator.String{
+					&stringNetworkAddressValidator{},
+				},
+			},
 			KeyProtocol: schema.StringAttribute{
 				MarkdownDescription: "The transport layer protocol to forward public traffic to (defaults to 'tcp')",
 				Optional:            true,
@@ -272,7 +439,7 @@ func (r *KawaiiResource) SchemaForwardRule() schema.NestedAttributeObject {
 				},
 			},
 			KeyPorts: schema.StringAttribute{
-				MarkdownDescription: "The port (or list of ports) to forward public traffic from. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005).",
+				MarkdownDescription: "The port (or list of ports) to forward public traffic from. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005). Well-known service names (e.g. ssh, https) are accepted.",
 				Required:            true,
 				Validators: []validator.String{
 					&stringNetworkPortRangesValidator{},
@@ -282,14 +449,57 @@ func (r *KawaiiResource) SchemaForwardRule() schema.NestedAttributeObject {
 	}
 }
 
-func (r *KawaiiResource) SchemaNatRules() schema.ListNestedAttribute {
-	return schema.ListNestedAttribute{
-		MarkdownDescription: "Kawaii list of NAT forwarding rules. Kawaii will forward public Internet traffic from all public virtual IPs to requested private subnet IP addresses.",
+func (r *KawaiiResource) SchemaNatHealthCheck() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Optional target health check. Kawaii will only forward public traffic to the target private IP address as long as it is healthy.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			KeyPort: schema.Int64Attribute{
+				MarkdownDescription: "The target port to be probed.",
+				Required:            true,
+				Validators: []validator.Int64{
+					&intNetworkPortValidator{},
+				},
+			},
+			KeyInterval: schema.StringAttribute{
+				MarkdownDescription: "The interval between two consecutive probes (defaults to 10s).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiDefaultValueHealthCheck),
+				Validators: []validator.String{
+					&stringDurationValidator{},
+				},
+			},
+			KeyProtocol: schema.StringAttribute{
+				MarkdownDescription: "The transport layer protocol to probe target with (defaults to 'tcp').",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(KawaiiDefaultValueProtocol),
+				Validators: []validator.String{
+					&stringNetworkProtocolValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *KawaiiResource) SchemaNatRules() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "Kawaii set of NAT forwarding rules. Kawaii will forward public Internet traffic from all public virtual IPs to requested private subnet IP addresses. Rules are unordered, reordering them does not trigger any change.",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
+				KeySource: schema.StringAttribute{
+					MarkdownDescription: "The source IPv4/IPv6 address or CIDR to forward public traffic from (defaults to 0.0.0.0/0, use ::/0 for any IPv6 source).",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(KawaiiDefaultValueSource),
+					Validators: []validator.String{
+						&stringNetworkAddressValidator{},
+					},
+				},
 				KeyDestination: schema.StringAttribute{
-					MarkdownDescription: "Target private IP address to forward public traffic to.",
+					MarkdownDescription: "Target private IP address to forward public traffic to. Must belong to one of project's private subnets or VPC-peered ones.",
 					Required:            true,
 				},
 				KeyProtocol: schema.StringAttribute{
@@ -302,12 +512,15 @@ func (r *KawaiiResource) SchemaNatRules() schema.ListNestedAttribute {
 					},
 				},
 				KeyPorts: schema.StringAttribute{
-					MarkdownDescription: "The port (or list of ports) to forward public traffic from. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005).",
+					MarkdownDescription: "The port (or list of ports) to forward public traffic from. Ranges are accepted. Format is a-b,c-d (e.g. 443; 22,80,443; 80,443,3000-3005). Well-known service names (e.g. ssh, https) are accepted.",
 					Required:            true,
 					Validators: []validator.String{
 						&stringNetworkPortRangesValidator{},
 					},
 				},
+				KeyHealthCheck: r.SchemaNatHealthCheck(),
+				KeyDesc:        r.SchemaRuleDesc(),
+				KeyStats:       r.SchemaRuleStats(),
 			},
 		},
 	}
@@ -315,12 +528,12 @@ func (r *KawaiiResource) SchemaNatRules() schema.ListNestedAttribute {
 
 func (r *KawaiiResource) SchemaVpcPeerings() schema.ListNestedAttribute {
 	return schema.ListNestedAttribute{
-		MarkdownDescription: "Kawaii list of Kowabunga private VPC subnet peering rules.",
+		MarkdownDescription: "Kawaii list of Kowabunga private VPC subnet peering rules. Left unmanaged if unset, so that peerings can be declared through standalone `kowabunga_kawaii_vpc_peering` resources instead (both should not be combined on a given Kawaii). Importing a Kawaii leaves it unset: existing peerings are to be imported as standalone `kowabunga_kawaii_vpc_peering` resources, which then remain their only source of truth.",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
 				KeySubnet: schema.StringAttribute{
-					MarkdownDescription: "Kowabunga Subnet ID to be peered with (subnet local IP addresses will be automatically assigned to Kawaii instances).",
+					MarkdownDescription: "Kowabunga Subnet name or ID to be peered with (subnet local IP addresses will be automatically assigned to Kawaii instances).",
 					Required:            true,
 				},
 				KeyPolicy: schema.StringAttribute{
@@ -396,13 +609,47 @@ func (r *KawaiiResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			KeyProject: schema.StringAttribute{
 				MarkdownDescription: "Associated project name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyZones: schema.ListAttribute{
+				MarkdownDescription: "List of region's zones names or IDs the Kawaii is to be spread over, one virtual IP per zone (defaults to all region's zones, for high-availability). Use a single zone for a cheaper, non-redundant, gateway. Changing it forces Kawaii re-creation.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
 			KeyNetworkConfig: r.SchemaNetworkConfig(),
-			KeyIngressRules:  r.SchemaIngressRules(),
+			KeyNetworkConfigJSON: schema.StringAttribute{
+				MarkdownDescription: "Kawaii assigned virtual IPs per-zone addresses, serialized as JSON (read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyIngressRules: r.SchemaIngressRules(),
+			KeyRulesetHash: schema.StringAttribute{
+				MarkdownDescription: "Stable SHA-256 fingerprint of the whole normalized ruleset (ingress, egress, NAT and VPC peering rules, rules counters excluded), as enforced by Kawaii (read-only). Changes whenever any rule does, handy to trigger downstream resources or spot drift at a glance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			KeyEgressPolicy: schema.StringAttribute{
 				MarkdownDescription: "Kawaii default public traffic firewall egress policy: 'accept' (default) or 'drop'",
 				Optional:            true,
@@ -413,22 +660,414 @@ func (r *KawaiiResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			KeyEgressRules: r.SchemaEgressRules(),
+			KeyLogPolicy: schema.BoolAttribute{
+				MarkdownDescription: "Whether to log public traffic packets matching Kawaii default firewall ingress and egress policies (default: **false**)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(KawaiiDefaultValueLog),
+			},
 			KeyNatRules:    r.SchemaNatRules(),
 			KeyVpcPeerings: r.SchemaVpcPeerings(),
+			KeyValidateRules: schema.BoolAttribute{
+				MarkdownDescription: "Whether to statically analyze public firewall ingress and egress rules at plan time, warning about overlapping rules and rules shadowed by a higher priority one with a different action (default: **true**). Analysis is purely advisory and never prevents the plan from being applied.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(KawaiiDefaultValueValidateRules),
+			},
 		},
 	}
-	maps.Copy(resp.Schema.Attributes, resourceAttributesWithoutName(&ctx))
+	maps.Copy(resp.Schema.Attributes, resourceAttributesWithoutName(&ctx))
+}
+
+func (r *KawaiiResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data KawaiiResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ICMP ingress rules are port-less, TCP/UDP ones require ports
+	ingressRules := make([]types.Object, 0, len(data.IngressRules.Elements()))
+	resp.Diagnostics.Append(data.IngressRules.ElementsAs(ctx, &ingressRules, false)...)
+	for _, ir := range ingressRules {
+		rule := KawaiiIngressRule{}
+		diags := ir.As(ctx, &rule, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    true,
+			UnhandledUnknownAsEmpty: true,
+		})
+		if diags.HasError() || rule.Protocol.IsUnknown() || rule.Ports.IsUnknown() {
+			continue
+		}
+
+		p := path.Root(KeyIngressRules).AtSetValue(ir).AtName(KeyPorts)
+		icmp := strings.ToLower(rule.Protocol.ValueString()) == KawaiiProtocolICMP
+		if icmp && rule.Ports.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(p, ErrorInvalidFirewallRule,
+				fmt.Sprintf("%s: ports must be left empty for 'icmp' protocol", ErrorInvalidFirewallRule))
+		}
+		if !icmp && rule.Ports.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(p, ErrorInvalidFirewallRule,
+				fmt.Sprintf("%s: ports are required for '%s' protocol", ErrorInvalidFirewallRule, rule.Protocol.ValueString()))
+		}
+	}
+}
+
+func (r *KawaiiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *KawaiiResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// unspecified egress rules action is planned as the inverse of egress policy, as read back
+	var config *KawaiiResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	egressRules, diags := kawaiiPlanEgressRulesAction(ctx, config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.EgressRules = egressRules
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(KeyEgressRules), egressRules)...)
+
+	// ruleset fingerprint is to be recomputed upon any rule change
+	if !req.State.Raw.IsNull() {
+		var state *KawaiiResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !plan.IngressRules.Equal(state.IngressRules) || !plan.EgressRules.Equal(state.EgressRules) ||
+			!plan.NatRules.Equal(state.NatRules) || !plan.VpcPeerings.Equal(state.VpcPeerings) ||
+			!plan.EgressPolicy.Equal(state.EgressPolicy) || !plan.LogPolicy.Equal(state.LogPolicy) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(KeyRulesetHash), types.StringUnknown())...)
+		}
+	}
+
+	if !plan.ValidateRules.ValueBool() {
+		return
+	}
+	kawaiiFirewallLint(KeyIngressRules, kawaiiLintIngressRules(ctx, plan), resp)
+	kawaiiFirewallLint(KeyEgressRules, kawaiiLintEgressRules(ctx, plan), resp)
+}
+
+// returns the action applied to egress rules without explicit one, i.e. the inverse of egress policy
+func kawaiiEgressDefaultAction(policy string) string {
+	if policy == KawaiiPolicyDrop {
+		return KawaiiPolicyAccept
+	}
+	return KawaiiPolicyDrop
+}
+
+// resolves planned egress rules unknown action, unless set from a yet unknown value
+func kawaiiPlanEgressRulesAction(ctx context.Context, config *KawaiiResourceModel, plan *KawaiiResourceModel) (types.Set, diag.Diagnostics) {
+	if plan.EgressPolicy.IsUnknown() || plan.EgressRules.IsNull() || plan.EgressRules.IsUnknown() {
+		return plan.EgressRules, nil
+	}
+	for _, er := range config.EgressRules.Elements() {
+		rule, ok := er.(types.Object)
+		if !ok || rule.IsNull() || rule.IsUnknown() || rule.Attributes()[KeyAction].IsUnknown() {
+			return plan.EgressRules, nil
+		}
+	}
+
+	rules := []attr.Value{}
+	for _, er := range plan.EgressRules.Elements() {
+		rule, ok := er.(types.Object)
+		if !ok || rule.IsNull() || rule.IsUnknown() {
+			return plan.EgressRules, nil
+		}
+		attrs := maps.Clone(rule.Attributes())
+		if attrs[KeyAction].IsUnknown() {
+			attrs[KeyAction] = types.StringValue(kawaiiEgressDefaultAction(plan.EgressPolicy.ValueString()))
+		}
+		object, diags := types.ObjectValue(rule.AttributeTypes(ctx), attrs)
+		if diags.HasError() {
+			return plan.EgressRules, diags
+		}
+		rules = append(rules, object)
+	}
+	return types.SetValue(plan.EgressRules.ElementType(ctx), rules)
+}
+
+////////////////////////////////////////////////////
+// static analysis of kawaii public firewall rules //
+////////////////////////////////////////////////////
+
+// normalized firewall rule, as evaluated by Kawaii
+type kawaiiLintRule struct {
+	Rule      types.Object
+	Key       string
+	Action    string
+	Address   string
+	Protocol  string
+	Ports     [][2]uint64
+	PortsSpec string
+	Priority  int64
+}
+
+func kawaiiLintNewRule(object types.Object, action string, address types.String, protocol types.String, ports types.String, priority types.Int64) (kawaiiLintRule, bool) {
+	if address.IsUnknown() || protocol.IsUnknown() || ports.IsUnknown() || priority.IsUnknown() {
+		return kawaiiLintRule{}, false
+	}
+
+	rule := kawaiiLintRule{
+		Rule:      object,
+		Key:       kawaiiRuleKey(protocol.ValueString(), ports.ValueString(), priority.ValueInt64(), address.ValueString()),
+		Action:    action,
+		Address:   address.ValueString(),
+		Protocol:  strings.ToLower(protocol.ValueString()),
+		Ports:     networkPortsRanges(networkPortsExpand(ports.ValueString())),
+		PortsSpec: networkPortsCanonical(ports.ValueString()),
+		Priority:  priority.ValueInt64(),
+	}
+
+	// ICMP is port-less, matching all traffic of its kind
+	if rule.Protocol == KawaiiProtocolICMP {
+		rule.Ports = [][2]uint64{{0, 65535}}
+	}
+	return rule, len(rule.Ports) > 0
+}
+
+func kawaiiLintIngressRules(ctx context.Context, d *KawaiiResourceModel) []kawaiiLintRule {
+	lint := []kawaiiLintRule{}
+	ingressRules := make([]types.Object, 0, len(d.IngressRules.Elements()))
+	d.IngressRules.ElementsAs(ctx, &ingressRules, false)
+	for _, ir := range ingressRules {
+		rule := KawaiiIngressRule{}
+		diags := ir.As(ctx, &rule, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    true,
+			UnhandledUnknownAsEmpty: true,
+		})
+		if diags.HasError() {
+			continue
+		}
+
+		// ingress rules are always explicitly accepted
+		r, ok := kawaiiLintNewRule(ir, KawaiiPolicyAccept, rule.Source, rule.Protocol, rule.Ports, rule.Priority)
+		if ok {
+			lint = append(lint, r)
+		}
+	}
+	return lint
+}
+
+func kawaiiLintEgressRules(ctx context.Context, d *KawaiiResourceModel) []kawaiiLintRule {
+	lint := []kawaiiLintRule{}
+	if d.EgressPolicy.IsUnknown() {
+		return lint
+	}
+
+	egressRules := make([]types.Object, 0, len(d.EgressRules.Elements()))
+	d.EgressRules.ElementsAs(ctx, &egressRules, false)
+	for _, er := range egressRules {
+		rule := KawaiiEgressRule{}
+		diags := er.As(ctx, &rule, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    true,
+			UnhandledUnknownAsEmpty: true,
+		})
+		if diags.HasError() || rule.Action.IsUnknown() {
+			continue
+		}
+
+		// unspecified action falls back to the inverse of egress policy
+		action := rule.Action.ValueString()
+		if rule.Action.IsNull() {
+			action = kawaiiEgressDefaultAction(d.EgressPolicy.ValueString())
+		}
+
+		r, ok := kawaiiLintNewRule(er, action, rule.Destination, rule.Protocol, rule.Ports, rule.Priority)
+		if ok {
+			lint = append(lint, r)
+		}
+	}
+	return lint
+}
+
+// checks whether address a overlaps (or fully covers) address b, subnet references only matching themselves
+func kawaiiLintAddressMatch(a string, b string) (bool, bool) {
+	pa, errA := netip.ParsePrefix(a)
+	if errA != nil {
+		addr, err := netip.ParseAddr(a)
+		if err == nil {
+			pa, errA = addr.Prefix(addr.BitLen())
+		}
+	}
+	pb, errB := netip.ParsePrefix(b)
+	if errB != nil {
+		addr, err := netip.ParseAddr(b)
+		if err == nil {
+			pb, errB = addr.Prefix(addr.BitLen())
+		}
+	}
+	if errA != nil || errB != nil {
+		return a == b, a == b
+	}
+
+	pa, pb = pa.Masked(), pb.Masked()
+	if !pa.Overlaps(pb) {
+		return false, false
+	}
+	return true, pa.Bits() <= pb.Bits()
+}
+
+// checks whether port ranges a overlap (or fully cover) port ranges b
+func kawaiiLintPortsMatch(a [][2]uint64, b [][2]uint64) (bool, bool) {
+	overlaps := false
+	covers := true
+	for _, rb := range b {
+		covered := false
+		for _, ra := range a {
+			if ra[0] <= rb[1] && rb[0] <= ra[1] {
+				overlaps = true
+			}
+			if ra[0] <= rb[0] && rb[1] <= ra[1] {
+				covered = true
+			}
+		}
+		covers = covers && covered
+	}
+	return overlaps, overlaps && covers
+}
+
+// human-readable rule reference, for diagnostics
+func (r kawaiiLintRule) String() string {
+	spec := r.Protocol
+	if r.PortsSpec != "" {
+		spec += "/" + r.PortsSpec
+	}
+	return fmt.Sprintf("%s %s rule for %s (priority %d)", r.Action, spec, r.Address, r.Priority)
+}
+
+// warns about rules overlapping with (or shadowed by) a prior evaluated one
+func kawaiiFirewallLint(key string, rules []kawaiiLintRule, resp *resource.ModifyPlanResponse) {
+	// same evaluation order as Kawaii's
+	slices.SortStableFunc(rules, func(a, b kawaiiLintRule) int {
+		return cmp.Or(cmp.Compare(a.Priority, b.Priority), cmp.Compare(a.Key, b.Key))
+	})
+
+	for j, later := range rules {
+		for _, prior := range rules[:j] {
+			if prior.Protocol != later.Protocol {
+				continue
+			}
+			addrOverlaps, addrCovers := kawaiiLintAddressMatch(prior.Address, later.Address)
+			portsOverlap, portsCover := kawaiiLintPortsMatch(prior.Ports, later.Ports)
+			if !addrOverlaps || !portsOverlap {
+				continue
+			}
+
+			p := path.Root(key).AtSetValue(later.Rule)
+			shadowed := addrCovers && portsCover
+			switch {
+			case prior.Action != later.Action && shadowed:
+				resp.Diagnostics.AddAttributeWarning(p, WarningFirewallConflict,
+					fmt.Sprintf("%s: %s is fully shadowed by prior evaluated %s and will never match", WarningFirewallConflict, later, prior))
+			case prior.Action != later.Action:
+				resp.Diagnostics.AddAttributeWarning(p, WarningFirewallConflict,
+					fmt.Sprintf("%s: %s is partially shadowed by prior evaluated %s on overlapping ports", WarningFirewallConflict, later, prior))
+			case shadowed:
+				resp.Diagnostics.AddAttributeWarning(p, WarningFirewallConflict,
+					fmt.Sprintf("%s: %s is redundant with prior evaluated %s", WarningFirewallConflict, later, prior))
+			default:
+				resp.Diagnostics.AddAttributeWarning(p, WarningFirewallConflict,
+					fmt.Sprintf("%s: %s ports overlap with %s", WarningFirewallConflict, later, prior))
+			}
+		}
+	}
+}
+
+//////////////////////////////////////////////////////////////
+// converts kawaii from Terraform model to Kowabunga API model //
+//////////////////////////////////////////////////////////////
+
+func kawaiiRulePriority(priority *int64) int64 {
+	if priority == nil {
+		return KawaiiDefaultValuePriority
+	}
+	return *priority
+}
+
+func kawaiiRuleDesc(desc *string) string {
+	if desc == nil {
+		return KawaiiDefaultValueRuleDesc
+	}
+	return *desc
+}
+
+func kawaiiRuleLog(log *bool) bool {
+	if log == nil {
+		return KawaiiDefaultValueLog
+	}
+	return *log
+}
+
+func kawaiiRuleStateful(stateful *bool) bool {
+	if stateful == nil {
+		return KawaiiDefaultValueStateful
+	}
+	return *stateful
+}
+
+func kawaiiRuleProtocol(protocol *string) string {
+	if protocol == nil {
+		return KawaiiDefaultValueProtocol
+	}
+	return *protocol
 }
 
-//////////////////////////////////////////////////////////////
-// converts kawaii from Terraform model to Kowabunga API model //
-//////////////////////////////////////////////////////////////
+func kawaiiRuleAddress(address *string, defaultAddress string) string {
+	if address == nil {
+		return defaultAddress
+	}
+	return *address
+}
+
+func kawaiiIngressRuleKey(rule sdk.KawaiiFirewallIngressRule) string {
+	return kawaiiRuleKey(kawaiiRuleProtocol(rule.Protocol), rule.Ports, kawaiiRulePriority(rule.Priority), kawaiiRuleAddress(rule.Source, KawaiiDefaultValueSource))
+}
+
+func kawaiiEgressRuleKey(rule sdk.KawaiiFirewallEgressRule) string {
+	return kawaiiRuleKey(kawaiiRuleProtocol(rule.Protocol), rule.Ports, kawaiiRulePriority(rule.Priority), kawaiiRuleAddress(rule.Destination, KawaiiDefaultValueDestination))
+}
+
+func kawaiiNatRuleKey(rule sdk.KawaiiDNatRule) string {
+	return kawaiiRuleKey(kawaiiRuleProtocol(rule.Protocol), rule.Ports, KawaiiDefaultValuePriority, kawaiiRuleAddress(rule.Source, KawaiiDefaultValueSource), rule.Destination)
+}
+
+// sorts rules by ascending priority, rules of equal priority being sorted canonically, as rules sets are unordered
+func kawaiiSortIngressRules(rules []sdk.KawaiiFirewallIngressRule) {
+	slices.SortStableFunc(rules, func(a, b sdk.KawaiiFirewallIngressRule) int {
+		return cmp.Or(cmp.Compare(kawaiiRulePriority(a.Priority), kawaiiRulePriority(b.Priority)), cmp.Compare(kawaiiIngressRuleKey(a), kawaiiIngressRuleKey(b)))
+	})
+}
+
+func kawaiiSortEgressRules(rules []sdk.KawaiiFirewallEgressRule) {
+	slices.SortStableFunc(rules, func(a, b sdk.KawaiiFirewallEgressRule) int {
+		return cmp.Or(cmp.Compare(kawaiiRulePriority(a.Priority), kawaiiRulePriority(b.Priority)), cmp.Compare(kawaiiEgressRuleKey(a), kawaiiEgressRuleKey(b)))
+	})
+}
+
+func kawaiiSortNatRules(rules []sdk.KawaiiDNatRule) {
+	slices.SortStableFunc(rules, func(a, b sdk.KawaiiDNatRule) int {
+		return cmp.Compare(kawaiiNatRuleKey(a), kawaiiNatRuleKey(b))
+	})
+}
 
-func kawaiiFirewallModel(ctx *context.Context, d *KawaiiResourceModel) sdk.KawaiiFirewall {
+func kawaiiFirewallModel(ctx *context.Context, d *KawaiiResourceModel, sources map[string]string) sdk.KawaiiFirewall {
 	fwModel := sdk.KawaiiFirewall{
 		Ingress:      []sdk.KawaiiFirewallIngressRule{},
 		EgressPolicy: d.EgressPolicy.ValueStringPointer(),
 		Egress:       []sdk.KawaiiFirewallEgressRule{},
+		LogPolicy:    d.LogPolicy.ValueBoolPointer(),
 	}
 
 	// Ingress Rules
@@ -451,12 +1090,36 @@ func kawaiiFirewallModel(ctx *context.Context, d *KawaiiResourceModel) sdk.Kawai
 			}
 		}
 
+		// ICMP is port-less
+		ports := networkPortsCanonical(rule.Ports.ValueString())
+		if strings.ToLower(rule.Protocol.ValueString()) == KawaiiProtocolICMP {
+			ports = ""
+		}
+
+		// subnet references are replaced by their CIDR
+		source := rule.Source.ValueStringPointer()
+		cidr, ok := sources[rule.Source.ValueString()]
+		if ok {
+			source = &cidr
+		}
+
+		var rateLimit *string
+		if rule.RateLimit.ValueString() != KawaiiDefaultValueRateLimit {
+			rateLimit = rule.RateLimit.ValueStringPointer()
+		}
+
 		fwModel.Ingress = append(fwModel.Ingress, sdk.KawaiiFirewallIngressRule{
-			Source:   rule.Source.ValueStringPointer(),
-			Protocol: rule.Protocol.ValueStringPointer(),
-			Ports:    rule.Ports.ValueString(),
+			Description: rule.Desc.ValueStringPointer(),
+			Source:      source,
+			Protocol:    rule.Protocol.ValueStringPointer(),
+			Ports:       ports,
+			Priority:    rule.Priority.ValueInt64Pointer(),
+			Log:         rule.Log.ValueBoolPointer(),
+			Stateful:    rule.Stateful.ValueBoolPointer(),
+			RateLimit:   rateLimit,
 		})
 	}
+	kawaiiSortIngressRules(fwModel.Ingress)
 
 	// Egress Rules
 	egressRules := make([]types.Object, 0, len(d.EgressRules.Elements()))
@@ -478,12 +1141,24 @@ func kawaiiFirewallModel(ctx *context.Context, d *KawaiiResourceModel) sdk.Kawai
 			}
 		}
 
+		// unspecified action falls back to the inverse of egress policy
+		var action *string
+		if !rule.Action.IsNull() && !rule.Action.IsUnknown() {
+			action = rule.Action.ValueStringPointer()
+		}
+
 		fwModel.Egress = append(fwModel.Egress, sdk.KawaiiFirewallEgressRule{
+			Description: rule.Desc.ValueStringPointer(),
+			Action:      action,
 			Destination: rule.Destination.ValueStringPointer(),
 			Protocol:    rule.Protocol.ValueStringPointer(),
-			Ports:       rule.Ports.ValueString(),
+			Ports:       networkPortsCanonical(rule.Ports.ValueString()),
+			Priority:    rule.Priority.ValueInt64Pointer(),
+			Log:         rule.Log.ValueBoolPointer(),
+			Stateful:    rule.Stateful.ValueBoolPointer(),
 		})
 	}
+	kawaiiSortEgressRules(fwModel.Egress)
 
 	return fwModel
 }
@@ -510,17 +1185,183 @@ func kawaiiNatRulesModel(ctx *context.Context, d *KawaiiResourceModel) []sdk.Kaw
 				tflog.Error(*ctx, err.Detail())
 			}
 		}
+		// optional target health check
+		var healthCheck *sdk.KawaiiDNatHealthCheck
+		if !rule.HealthCheck.IsNull() && !rule.HealthCheck.IsUnknown() {
+			hc := KawaiiNatHealthCheck{}
+			diags := rule.HealthCheck.As(*ctx, &hc, basetypes.ObjectAsOptions{
+				UnhandledNullAsEmpty:    true,
+				UnhandledUnknownAsEmpty: true,
+			})
+			if diags.HasError() {
+				for _, err := range diags.Errors() {
+					tflog.Error(*ctx, err.Detail())
+				}
+			}
+			healthCheck = &sdk.KawaiiDNatHealthCheck{
+				Port:     hc.Port.ValueInt64(),
+				Interval: hc.Interval.ValueStringPointer(),
+				Protocol: hc.Protocol.ValueStringPointer(),
+			}
+		}
+
 		natModel = append(natModel, sdk.KawaiiDNatRule{
+			Description: rule.Desc.ValueStringPointer(),
+			Source:      rule.Source.ValueStringPointer(),
 			Destination: rule.Destination.ValueString(),
 			Protocol:    rule.Protocol.ValueStringPointer(),
-			Ports:       rule.Ports.ValueString(),
+			Ports:       networkPortsCanonical(rule.Ports.ValueString()),
+			HealthCheck: healthCheck,
 		})
 	}
+	kawaiiSortNatRules(natModel)
 
 	return natModel
 }
 
-func kawaiiVpcPeeringsModel(ctx *context.Context, d *KawaiiResourceModel) []sdk.KawaiiVpcPeering {
+// unrestricted VPC forwarding addresses are left unset
+func kawaiiPeeringCIDR(cidr types.String) *string {
+	if cidr.ValueString() == KawaiiDefaultValuePeeringCIDR {
+		return nil
+	}
+	return cidr.ValueStringPointer()
+}
+
+// resolves ingress rules subnets names or IDs sources into subnet CIDRs
+func getKawaiiIngressSourcesCIDRs(ctx context.Context, data *KowabungaProviderData, d *KawaiiResourceModel) (map[string]string, error) {
+	sources := map[string]string{}
+
+	ingressRules := make([]types.Object, 0, len(d.IngressRules.Elements()))
+	diags := d.IngressRules.ElementsAs(ctx, &ingressRules, false)
+	if diags.HasError() {
+		for _, err := range diags.Errors() {
+			tflog.Debug(ctx, err.Detail())
+		}
+	}
+
+	for _, ir := range ingressRules {
+		rule := KawaiiIngressRule{}
+		diags := ir.As(ctx, &rule, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    true,
+			UnhandledUnknownAsEmpty: true,
+		})
+		if diags.HasError() {
+			for _, err := range diags.Errors() {
+				tflog.Error(ctx, err.Detail())
+			}
+		}
+
+		source := rule.Source.ValueString()
+		if networkAddressIsValid(source) {
+			continue
+		}
+		subnetId, err := getSubnetID(ctx, data, source)
+		if err != nil {
+			return sources, fmt.Errorf("%s: %s", err.Error(), source)
+		}
+		subnet, _, err := data.K.SubnetAPI.ReadSubnet(ctx, subnetId).Execute()
+		if err != nil {
+			return sources, err
+		}
+		sources[source] = subnet.Cidr
+	}
+
+	return sources, nil
+}
+
+// resolves VPC peerings subnets names or IDs into subnet IDs
+func getKawaiiVpcPeeringsSubnetIDs(ctx context.Context, data *KowabungaProviderData, d *KawaiiResourceModel) (map[string]string, error) {
+	subnets := map[string]string{}
+
+	peerings := make([]types.Object, 0, len(d.VpcPeerings.Elements()))
+	diags := d.VpcPeerings.ElementsAs(ctx, &peerings, false)
+	if diags.HasError() {
+		for _, err := range diags.Errors() {
+			tflog.Debug(ctx, err.Detail())
+		}
+	}
+
+	for _, p := range peerings {
+		vp := KawaiiVpcPeering{}
+		diags := p.As(ctx, &vp, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    true,
+			UnhandledUnknownAsEmpty: true,
+		})
+		if diags.HasError() {
+			for _, err := range diags.Errors() {
+				tflog.Error(ctx, err.Detail())
+			}
+		}
+
+		subnet := vp.Subnet.ValueString()
+		subnetId, err := getSubnetID(ctx, data, subnet)
+		if err != nil {
+			return subnets, fmt.Errorf("%s: %s", err.Error(), subnet)
+		}
+		subnets[subnet] = subnetId
+	}
+
+	return subnets, nil
+}
+
+// ensures NAT rules forward traffic to reachable addresses, i.e. within project's private or VPC-peered subnets,
+// only warning about unreachable ones when VPC peerings are not managed inline, as standalone ones may be set up later on
+func checkKawaiiNatDestinations(ctx context.Context, data *KowabungaProviderData, d *KawaiiResourceModel, projectId string, subnets map[string]string, diags *diag.Diagnostics) error {
+	rules := kawaiiNatRulesModel(&ctx, d)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	project, _, err := data.K.ProjectAPI.ReadProject(ctx, projectId).Execute()
+	if err != nil {
+		return err
+	}
+	subnetIds := []string{}
+	for _, p := range project.PrivateSubnets {
+		if p.Value != nil {
+			subnetIds = append(subnetIds, *p.Value)
+		}
+	}
+	for _, id := range subnets {
+		subnetIds = append(subnetIds, id)
+	}
+
+	prefixes := []netip.Prefix{}
+	for _, id := range subnetIds {
+		subnet, _, err := data.K.SubnetAPI.ReadSubnet(ctx, id).Execute()
+		if err != nil {
+			return err
+		}
+		prefix, err := netip.ParsePrefix(subnet.Cidr)
+		if err != nil {
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	for _, rule := range rules {
+		addr, err := netip.ParseAddr(rule.Destination)
+		if err != nil {
+			return fmt.Errorf("%s: invalid destination address %s", ErrorInvalidNatRule, rule.Destination)
+		}
+		reachable := slices.ContainsFunc(prefixes, func(p netip.Prefix) bool {
+			return p.Contains(addr)
+		})
+		if reachable {
+			continue
+		}
+		if d.VpcPeerings.IsNull() {
+			diags.AddAttributeWarning(path.Root(KeyNatRules), WarningNatUnreachable,
+				fmt.Sprintf("%s: destination %s is outside of project's private and VPC-peered subnets, it must be peered through a kowabunga_%s resource", WarningNatUnreachable, rule.Destination, KawaiiVpcPeeringResourceName))
+			continue
+		}
+		return fmt.Errorf("%s: destination %s is outside of project's private and VPC-peered subnets", ErrorInvalidNatRule, rule.Destination)
+	}
+
+	return nil
+}
+
+func kawaiiVpcPeeringsModel(ctx *context.Context, d *KawaiiResourceModel, subnets map[string]string) []sdk.KawaiiVpcPeering {
 	vpModel := []sdk.KawaiiVpcPeering{}
 
 	peerings := make([]types.Object, 0, len(d.VpcPeerings.Elements()))
@@ -566,8 +1407,10 @@ func kawaiiVpcPeeringsModel(ctx *context.Context, d *KawaiiResourceModel) []sdk.
 			}
 
 			ingressModel = append(ingressModel, sdk.KawaiiVpcForwardRule{
-				Protocol: rule.Protocol.ValueStringPointer(),
-				Ports:    rule.Ports.ValueString(),
+				Source:      kawaiiPeeringCIDR(rule.Source),
+				Destination: kawaiiPeeringCIDR(rule.Destination),
+				Protocol:    rule.Protocol.ValueStringPointer(),
+				Ports:       networkPortsCanonical(rule.Ports.ValueString()),
 			})
 		}
 
@@ -594,13 +1437,20 @@ func kawaiiVpcPeeringsModel(ctx *context.Context, d *KawaiiResourceModel) []sdk.
 			}
 
 			egressModel = append(egressModel, sdk.KawaiiVpcForwardRule{
-				Protocol: rule.Protocol.ValueStringPointer(),
-				Ports:    rule.Ports.ValueString(),
+				Source:      kawaiiPeeringCIDR(rule.Source),
+				Destination: kawaiiPeeringCIDR(rule.Destination),
+				Protocol:    rule.Protocol.ValueStringPointer(),
+				Ports:       networkPortsCanonical(rule.Ports.ValueString()),
 			})
 		}
 
+		subnetId, ok := subnets[vp.Subnet.ValueString()]
+		if !ok {
+			subnetId = vp.Subnet.ValueString()
+		}
+
 		vpModel = append(vpModel, sdk.KawaiiVpcPeering{
-			Subnet:  vp.Subnet.ValueString(),
+			Subnet:  subnetId,
 			Policy:  vp.Policy.ValueStringPointer(),
 			Ingress: ingressModel,
 			Egress:  egressModel,
@@ -610,12 +1460,12 @@ func kawaiiVpcPeeringsModel(ctx *context.Context, d *KawaiiResourceModel) []sdk.
 	return vpModel
 }
 
-func kawaiiResourceToModel(ctx *context.Context, d *KawaiiResourceModel) sdk.Kawaii {
+func kawaiiResourceToModel(ctx *context.Context, d *KawaiiResourceModel, subnets map[string]string, sources map[string]string) sdk.Kawaii {
 	return sdk.Kawaii{
 		Description: d.Desc.ValueStringPointer(),
-		Firewall:    kawaiiFirewallModel(ctx, d),
+		Firewall:    kawaiiFirewallModel(ctx, d, sources),
 		Dnat:        kawaiiNatRulesModel(ctx, d),
-		VpcPeerings: kawaiiVpcPeeringsModel(ctx, d),
+		VpcPeerings: kawaiiVpcPeeringsModel(ctx, d, subnets),
 	}
 }
 
@@ -681,39 +1531,210 @@ func kawaiiModelToNetworkConfig(ctx *context.Context, r *sdk.Kawaii, d *KawaiiRe
 
 	// resulting object
 	d.NetworkCfg, _ = types.ObjectValue(ncType, nc)
+
+	// JSON-serialized flavor
+	type zoneNetCfgJSON struct {
+		Zone      string `json:"zone"`
+		PublicIp  string `json:"public_ip"`
+		PrivateIp string `json:"private_ip"`
+	}
+	netCfgJSON := struct {
+		PublicIPs  []string         `json:"public_ips"`
+		PrivateIPs []string         `json:"private_ips"`
+		Zones      []zoneNetCfgJSON `json:"zones"`
+	}{
+		PublicIPs:  append([]string{}, r.Netip.Public...),
+		PrivateIPs: append([]string{}, r.Netip.Private...),
+		Zones:      []zoneNetCfgJSON{},
+	}
+	for _, z := range r.Netip.Zones {
+		netCfgJSON.Zones = append(netCfgJSON.Zones, zoneNetCfgJSON{
+			Zone:      z.Zone,
+			PublicIp:  z.Public,
+			PrivateIp: z.Private,
+		})
+	}
+	netCfg, err := json.Marshal(netCfgJSON)
+	if err != nil {
+		tflog.Error(*ctx, err.Error())
+	}
+	d.NetworkCfgJSON = types.StringValue(string(netCfg))
+}
+
+var kawaiiRuleStatsType = map[string]attr.Type{
+	KeyPackets: types.Int64Type,
+	KeyBytes:   types.Int64Type,
+}
+
+func kawaiiModelToRuleStats(stats *sdk.KawaiiFirewallRuleStats) types.Object {
+	var packets, bytes int64 = 0, 0
+	if stats != nil {
+		packets = stats.Packets
+		bytes = stats.Bytes
+	}
+	object, _ := types.ObjectValue(kawaiiRuleStatsType, map[string]attr.Value{
+		KeyPackets: types.Int64Value(packets),
+		KeyBytes:   types.Int64Value(bytes),
+	})
+	return object
+}
+
+// returns rules ports, as declared in Terraform model
+func kawaiiRulesPorts(rules []attr.Value) []string {
+	ports := []string{}
+	for _, e := range rules {
+		p := ""
+		rule, ok := e.(types.Object)
+		if ok {
+			v, ok := rule.Attributes()[KeyPorts].(types.String)
+			if ok {
+				p = v.ValueString()
+			}
+		}
+		ports = append(ports, p)
+	}
+	return ports
 }
 
-func kawaiiModelToFirewall(ctx *context.Context, r *sdk.Kawaii, d *KawaiiResourceModel) {
+// returns rules sources, as declared in Terraform model
+func kawaiiRulesSources(rules []attr.Value) []string {
+	sources := []string{}
+	for _, e := range rules {
+		s := ""
+		rule, ok := e.(types.Object)
+		if ok {
+			v, ok := rule.Attributes()[KeySource].(types.String)
+			if ok {
+				s = v.ValueString()
+			}
+		}
+		sources = append(sources, s)
+	}
+	return sources
+}
+
+// preserves declared subnet references, as long as they resolve to actual CIDR
+func kawaiiRuleSource(declared []string, idx int, actual string, sources map[string]string) string {
+	if idx >= 0 && idx < len(declared) && sources[declared[idx]] == actual {
+		return declared[idx]
+	}
+	return actual
+}
+
+// preserves declared ports (e.g. service names, ordering), as long as they are equivalent to actual ones
+func kawaiiRulePorts(declared []string, idx int, actual string) string {
+	if idx >= 0 && idx < len(declared) && networkPortsCanonical(declared[idx]) == networkPortsCanonical(actual) {
+		return declared[idx]
+	}
+	return actual
+}
+
+// identifies a rule by the traffic it matches, regardless of ports syntax
+func kawaiiRuleKey(protocol string, ports string, priority int64, peers ...string) string {
+	return strings.Join(append([]string{strings.ToLower(protocol), networkPortsCanonical(ports), fmt.Sprint(priority)}, peers...), ";")
+}
+
+// returns rules keys, as declared in Terraform model, peer subnet references being resolved to their CIDR
+func kawaiiRulesKeys(rules []attr.Value, sources map[string]string, peers ...string) []string {
+	keys := []string{}
+	for _, e := range rules {
+		k := ""
+		rule, ok := e.(types.Object)
+		if ok {
+			attributes := rule.Attributes()
+			protocol, _ := attributes[KeyProtocol].(types.String)
+			ports, _ := attributes[KeyPorts].(types.String)
+			priority := types.Int64Value(KawaiiDefaultValuePriority)
+			if p, ok := attributes[KeyPriority].(types.Int64); ok {
+				priority = p
+			}
+			addresses := []string{}
+			for _, peer := range peers {
+				address, _ := attributes[peer].(types.String)
+				a := address.ValueString()
+				if cidr, ok := sources[a]; ok {
+					a = cidr
+				}
+				addresses = append(addresses, a)
+			}
+			k = kawaiiRuleKey(protocol.ValueString(), ports.ValueString(), priority.ValueInt64(), addresses...)
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// pairs actual rules with declared ones of same key, as [actual, declared] indexes, in declared order
+// actual rules which have not been declared come last, with a -1 declared index
+func kawaiiRulesMatch(declared []string, actual []string) [][2]int {
+	matches := [][2]int{}
+	used := make([]bool, len(actual))
+	for d, key := range declared {
+		for a := range actual {
+			if !used[a] && actual[a] == key {
+				used[a] = true
+				matches = append(matches, [2]int{a, d})
+				break
+			}
+		}
+	}
+	for a := range actual {
+		if !used[a] {
+			matches = append(matches, [2]int{a, -1})
+		}
+	}
+	return matches
+}
+
+func kawaiiModelToFirewall(ctx *context.Context, r *sdk.Kawaii, d *KawaiiResourceModel, sources map[string]string) {
 	// ingress rules
 	ingressRules := []attr.Value{}
 	ingressRuleType := map[string]attr.Type{
-		KeySource:   types.StringType,
-		KeyProtocol: types.StringType,
-		KeyPorts:    types.StringType,
+		KeyDesc:      types.StringType,
+		KeySource:    types.StringType,
+		KeyProtocol:  types.StringType,
+		KeyPorts:     types.StringType,
+		KeyPriority:  types.Int64Type,
+		KeyLog:       types.BoolType,
+		KeyStateful:  types.BoolType,
+		KeyRateLimit: types.StringType,
+		KeyStats:     types.ObjectType{AttrTypes: kawaiiRuleStatsType},
 	}
+	ingressPorts := kawaiiRulesPorts(d.IngressRules.Elements())
+	ingressSources := kawaiiRulesSources(d.IngressRules.Elements())
+	ingressKeys := []string{}
 	for _, ir := range r.Firewall.Ingress {
-		source := KawaiiDefaultValueSource
-		if ir.Source != nil {
-			source = *ir.Source
-		}
-		protocol := KawaiiDefaultValueProtocol
-		if ir.Protocol != nil {
-			protocol = *ir.Protocol
+		ingressKeys = append(ingressKeys, kawaiiIngressRuleKey(ir))
+	}
+	// backend rules are canonically sorted, pair them back with declared ones
+	for _, match := range kawaiiRulesMatch(kawaiiRulesKeys(d.IngressRules.Elements(), sources, KeySource), ingressKeys) {
+		ir := r.Firewall.Ingress[match[0]]
+		idx := match[1]
+		source := kawaiiRuleAddress(ir.Source, KawaiiDefaultValueSource)
+		protocol := kawaiiRuleProtocol(ir.Protocol)
+		rateLimit := KawaiiDefaultValueRateLimit
+		if ir.RateLimit != nil {
+			rateLimit = *ir.RateLimit
 		}
 		r := map[string]attr.Value{
-			KeySource:   types.StringValue(source),
-			KeyProtocol: types.StringValue(protocol),
-			KeyPorts:    types.StringValue(ir.Ports),
+			KeyDesc:      types.StringValue(kawaiiRuleDesc(ir.Description)),
+			KeySource:    types.StringValue(kawaiiRuleSource(ingressSources, idx, source, sources)),
+			KeyProtocol:  types.StringValue(protocol),
+			KeyPorts:     types.StringValue(kawaiiRulePorts(ingressPorts, idx, ir.Ports)),
+			KeyPriority:  types.Int64Value(kawaiiRulePriority(ir.Priority)),
+			KeyLog:       types.BoolValue(kawaiiRuleLog(ir.Log)),
+			KeyStateful:  types.BoolValue(kawaiiRuleStateful(ir.Stateful)),
+			KeyRateLimit: types.StringValue(rateLimit),
+			KeyStats:     kawaiiModelToRuleStats(ir.Stats),
 		}
 		object, _ := types.ObjectValue(ingressRuleType, r)
 		ingressRules = append(ingressRules, object)
 	}
 
 	if len(r.Firewall.Ingress) == 0 {
-		d.IngressRules = types.ListNull(types.ObjectType{AttrTypes: ingressRuleType})
+		d.IngressRules = types.SetNull(types.ObjectType{AttrTypes: ingressRuleType})
 	} else {
-
-		d.IngressRules, _ = types.ListValue(types.ObjectType{AttrTypes: ingressRuleType}, ingressRules)
+		d.IngressRules, _ = types.SetValue(types.ObjectType{AttrTypes: ingressRuleType}, ingressRules)
 	}
 
 	// egress policy
@@ -723,109 +1744,185 @@ func kawaiiModelToFirewall(ctx *context.Context, r *sdk.Kawaii, d *KawaiiResourc
 		d.EgressPolicy = types.StringValue(KawaiiDefaultValueEgressPolicy)
 	}
 
+	// log policy
+	d.LogPolicy = types.BoolValue(kawaiiRuleLog(r.Firewall.LogPolicy))
+
 	// egress rules
 	egressRules := []attr.Value{}
 	egressRuleType := map[string]attr.Type{
+		KeyDesc:        types.StringType,
+		KeyAction:      types.StringType,
 		KeyDestination: types.StringType,
 		KeyProtocol:    types.StringType,
 		KeyPorts:       types.StringType,
+		KeyPriority:    types.Int64Type,
+		KeyLog:         types.BoolType,
+		KeyStateful:    types.BoolType,
+		KeyStats:       types.ObjectType{AttrTypes: kawaiiRuleStatsType},
 	}
+	egressPorts := kawaiiRulesPorts(d.EgressRules.Elements())
+	defaultAction := kawaiiEgressDefaultAction(d.EgressPolicy.ValueString())
+	egressKeys := []string{}
 	for _, er := range r.Firewall.Egress {
-		destination := KawaiiDefaultValueDestination
-		if er.Destination != nil {
-			destination = *er.Destination
-		}
-		protocol := KawaiiDefaultValueProtocol
-		if er.Protocol != nil {
-			protocol = *er.Protocol
+		egressKeys = append(egressKeys, kawaiiEgressRuleKey(er))
+	}
+	// backend rules are canonically sorted, pair them back with declared ones
+	for _, match := range kawaiiRulesMatch(kawaiiRulesKeys(d.EgressRules.Elements(), nil, KeyDestination), egressKeys) {
+		er := r.Firewall.Egress[match[0]]
+		idx := match[1]
+		action := defaultAction
+		if er.Action != nil {
+			action = *er.Action
 		}
+		destination := kawaiiRuleAddress(er.Destination, KawaiiDefaultValueDestination)
+		protocol := kawaiiRuleProtocol(er.Protocol)
 		r := map[string]attr.Value{
+			KeyDesc:        types.StringValue(kawaiiRuleDesc(er.Description)),
+			KeyAction:      types.StringValue(action),
 			KeyDestination: types.StringValue(destination),
 			KeyProtocol:    types.StringValue(protocol),
-			KeyPorts:       types.StringValue(er.Ports),
+			KeyPorts:       types.StringValue(kawaiiRulePorts(egressPorts, idx, er.Ports)),
+			KeyPriority:    types.Int64Value(kawaiiRulePriority(er.Priority)),
+			KeyLog:         types.BoolValue(kawaiiRuleLog(er.Log)),
+			KeyStateful:    types.BoolValue(kawaiiRuleStateful(er.Stateful)),
+			KeyStats:       kawaiiModelToRuleStats(er.Stats),
 		}
 		object, _ := types.ObjectValue(egressRuleType, r)
 		egressRules = append(egressRules, object)
 	}
 
 	if len(r.Firewall.Egress) == 0 {
-		d.EgressRules = types.ListNull(types.ObjectType{AttrTypes: egressRuleType})
+		d.EgressRules = types.SetNull(types.ObjectType{AttrTypes: egressRuleType})
 	} else {
-
-		d.EgressRules, _ = types.ListValue(types.ObjectType{AttrTypes: egressRuleType}, egressRules)
+		d.EgressRules, _ = types.SetValue(types.ObjectType{AttrTypes: egressRuleType}, egressRules)
 	}
 }
 
 func kawaiiModelToNatRules(ctx *context.Context, r *sdk.Kawaii, d *KawaiiResourceModel) {
 	rules := []attr.Value{}
+	healthCheckType := map[string]attr.Type{
+		KeyPort:     types.Int64Type,
+		KeyInterval: types.StringType,
+		KeyProtocol: types.StringType,
+	}
 	ruleType := map[string]attr.Type{
+		KeyDesc:        types.StringType,
+		KeySource:      types.StringType,
 		KeyDestination: types.StringType,
 		KeyProtocol:    types.StringType,
 		KeyPorts:       types.StringType,
+		KeyHealthCheck: types.ObjectType{AttrTypes: healthCheckType},
+		KeyStats:       types.ObjectType{AttrTypes: kawaiiRuleStatsType},
 	}
 
 	// empty rules ?
 	if len(r.Dnat) == 0 {
-		d.NatRules = types.ListNull(types.ObjectType{AttrTypes: ruleType})
+		d.NatRules = types.SetNull(types.ObjectType{AttrTypes: ruleType})
 		return
 	}
 
+	natPorts := kawaiiRulesPorts(d.NatRules.Elements())
+	natKeys := []string{}
 	for _, rule := range r.Dnat {
+		natKeys = append(natKeys, kawaiiNatRuleKey(rule))
+	}
+	// backend rules are canonically sorted, pair them back with declared ones
+	for _, match := range kawaiiRulesMatch(kawaiiRulesKeys(d.NatRules.Elements(), nil, KeySource, KeyDestination), natKeys) {
+		rule := r.Dnat[match[0]]
+		idx := match[1]
+		source := KawaiiDefaultValueSource
+		if rule.Source != nil {
+			source = *rule.Source
+		}
 		protocol := KawaiiDefaultValueProtocol
 		if rule.Protocol != nil {
 			protocol = *rule.Protocol
 		}
+		healthCheck := types.ObjectNull(healthCheckType)
+		if rule.HealthCheck != nil {
+			interval := KawaiiDefaultValueHealthCheck
+			if rule.HealthCheck.Interval != nil {
+				interval = *rule.HealthCheck.Interval
+			}
+			hcProtocol := KawaiiDefaultValueProtocol
+			if rule.HealthCheck.Protocol != nil {
+				hcProtocol = *rule.HealthCheck.Protocol
+			}
+			healthCheck, _ = types.ObjectValue(healthCheckType, map[string]attr.Value{
+				KeyPort:     types.Int64Value(rule.HealthCheck.Port),
+				KeyInterval: types.StringValue(interval),
+				KeyProtocol: types.StringValue(hcProtocol),
+			})
+		}
 		r := map[string]attr.Value{
+			KeyDesc:        types.StringValue(kawaiiRuleDesc(rule.Description)),
+			KeySource:      types.StringValue(source),
 			KeyDestination: types.StringValue(rule.Destination),
 			KeyProtocol:    types.StringValue(protocol),
-			KeyPorts:       types.StringValue(rule.Ports),
+			KeyPorts:       types.StringValue(kawaiiRulePorts(natPorts, idx, rule.Ports)),
+			KeyHealthCheck: healthCheck,
+			KeyStats:       kawaiiModelToRuleStats(rule.Stats),
 		}
 		object, _ := types.ObjectValue(ruleType, r)
 		rules = append(rules, object)
 	}
-	d.NatRules, _ = types.ListValue(types.ObjectType{AttrTypes: ruleType}, rules)
+	d.NatRules, _ = types.SetValue(types.ObjectType{AttrTypes: ruleType}, rules)
 }
 
-func kawaiiModelToVpcPeerings(ctx *context.Context, r *sdk.Kawaii, d *KawaiiResourceModel) {
-	vpc := []attr.Value{}
-	vpcType := map[string]attr.Type{
-		KeySubnet: types.StringType,
-		KeyPolicy: types.StringType,
-		KeyIngressRules: types.ListType{
-			ElemType: types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					KeyProtocol: types.StringType,
-					KeyPorts:    types.StringType,
-				},
+func kawaiiModelToPeeringCIDR(cidr *string) string {
+	if cidr == nil {
+		return KawaiiDefaultValuePeeringCIDR
+	}
+	return *cidr
+}
+
+var kawaiiVpcPeeringType = map[string]attr.Type{
+	KeySubnet: types.StringType,
+	KeyPolicy: types.StringType,
+	KeyIngressRules: types.ListType{
+		ElemType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				KeySource:      types.StringType,
+				KeyDestination: types.StringType,
+				KeyProtocol:    types.StringType,
+				KeyPorts:       types.StringType,
 			},
 		},
-		KeyEgressRules: types.ListType{
-			ElemType: types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					KeyProtocol: types.StringType,
-					KeyPorts:    types.StringType,
-				},
+	},
+	KeyEgressRules: types.ListType{
+		ElemType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				KeySource:      types.StringType,
+				KeyDestination: types.StringType,
+				KeyProtocol:    types.StringType,
+				KeyPorts:       types.StringType,
 			},
 		},
-		KeyNetworkConfig: types.ListType{
-			ElemType: types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					KeyZone:      types.StringType,
-					KeyPrivateIP: types.StringType,
-				},
+	},
+	KeyNetworkConfig: types.ListType{
+		ElemType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				KeyZone:      types.StringType,
+				KeyPrivateIP: types.StringType,
 			},
 		},
-	}
+	},
+}
+
+func kawaiiModelToVpcPeerings(ctx *context.Context, r *sdk.Kawaii, d *KawaiiResourceModel, subnets map[string]string) {
+	vpc := []attr.Value{}
 
 	// empty peerings ?
 	if len(r.VpcPeerings) == 0 {
-		d.VpcPeerings = types.ListNull(types.ObjectType{AttrTypes: vpcType})
+		d.VpcPeerings = types.ListNull(types.ObjectType{AttrTypes: kawaiiVpcPeeringType})
 		return
 	}
 
 	fwRuleType := map[string]attr.Type{
-		KeyProtocol: types.StringType,
-		KeyPorts:    types.StringType,
+		KeySource:      types.StringType,
+		KeyDestination: types.StringType,
+		KeyProtocol:    types.StringType,
+		KeyPorts:       types.StringType,
 	}
 
 	netCfgType := map[string]attr.Type{
@@ -833,7 +1930,26 @@ func kawaiiModelToVpcPeerings(ctx *context.Context, r *sdk.Kawaii, d *KawaiiReso
 		KeyPrivateIP: types.StringType,
 	}
 
-	for _, vp := range r.VpcPeerings {
+	priorPeerings := d.VpcPeerings.Elements()
+	for vpIdx, vp := range r.VpcPeerings {
+		subnet := vp.Subnet
+		ingressPorts := []string{}
+		egressPorts := []string{}
+		if vpIdx < len(priorPeerings) {
+			prior, ok := priorPeerings[vpIdx].(types.Object)
+			if ok {
+				// preserves declared subnet name, as long as it resolves to actual subnet
+				declared, _ := prior.Attributes()[KeySubnet].(types.String)
+				if subnets[declared.ValueString()] == vp.Subnet {
+					subnet = declared.ValueString()
+				}
+				ingress, _ := prior.Attributes()[KeyIngressRules].(types.List)
+				ingressPorts = kawaiiRulesPorts(ingress.Elements())
+				egress, _ := prior.Attributes()[KeyEgressRules].(types.List)
+				egressPorts = kawaiiRulesPorts(egress.Elements())
+			}
+		}
+
 		policy := KawaiiDefaultValueForwardPolicy
 		if vp.Policy != nil {
 			policy = *vp.Policy
@@ -841,15 +1957,17 @@ func kawaiiModelToVpcPeerings(ctx *context.Context, r *sdk.Kawaii, d *KawaiiReso
 
 		// ingress rules
 		ingressRules := []attr.Value{}
-		for _, ir := range vp.Ingress {
+		for idx, ir := range vp.Ingress {
 			protocol := KawaiiDefaultValueProtocol
 			if ir.Protocol != nil {
 				protocol = *ir.Protocol
 			}
 
 			rule := map[string]attr.Value{
-				KeyProtocol: types.StringValue(protocol),
-				KeyPorts:    types.StringValue(ir.Ports),
+				KeySource:      types.StringValue(kawaiiModelToPeeringCIDR(ir.Source)),
+				KeyDestination: types.StringValue(kawaiiModelToPeeringCIDR(ir.Destination)),
+				KeyProtocol:    types.StringValue(protocol),
+				KeyPorts:       types.StringValue(kawaiiRulePorts(ingressPorts, idx, ir.Ports)),
 			}
 			object, _ := types.ObjectValue(fwRuleType, rule)
 			ingressRules = append(ingressRules, object)
@@ -857,15 +1975,17 @@ func kawaiiModelToVpcPeerings(ctx *context.Context, r *sdk.Kawaii, d *KawaiiReso
 
 		// egress rules
 		egressRules := []attr.Value{}
-		for _, er := range vp.Egress {
+		for idx, er := range vp.Egress {
 			protocol := KawaiiDefaultValueProtocol
 			if er.Protocol != nil {
 				protocol = *er.Protocol
 			}
 
 			rule := map[string]attr.Value{
-				KeyProtocol: types.StringValue(protocol),
-				KeyPorts:    types.StringValue(er.Ports),
+				KeySource:      types.StringValue(kawaiiModelToPeeringCIDR(er.Source)),
+				KeyDestination: types.StringValue(kawaiiModelToPeeringCIDR(er.Destination)),
+				KeyProtocol:    types.StringValue(protocol),
+				KeyPorts:       types.StringValue(kawaiiRulePorts(egressPorts, idx, er.Ports)),
 			}
 			object, _ := types.ObjectValue(fwRuleType, rule)
 			egressRules = append(egressRules, object)
@@ -883,33 +2003,90 @@ func kawaiiModelToVpcPeerings(ctx *context.Context, r *sdk.Kawaii, d *KawaiiReso
 		}
 
 		r := map[string]attr.Value{
-			KeySubnet: types.StringValue(vp.Subnet),
+			KeySubnet: types.StringValue(subnet),
 			KeyPolicy: types.StringValue(policy),
 		}
 		r[KeyIngressRules], _ = types.ListValue(types.ObjectType{AttrTypes: fwRuleType}, ingressRules)
 		r[KeyEgressRules], _ = types.ListValue(types.ObjectType{AttrTypes: fwRuleType}, egressRules)
 		r[KeyNetworkConfig], _ = types.ListValue(types.ObjectType{AttrTypes: netCfgType}, netCfg)
 
-		object, _ := types.ObjectValue(vpcType, r)
+		object, _ := types.ObjectValue(kawaiiVpcPeeringType, r)
 		vpc = append(vpc, object)
 	}
-	d.VpcPeerings, _ = types.ListValue(types.ObjectType{AttrTypes: vpcType}, vpc)
+	d.VpcPeerings, _ = types.ListValue(types.ObjectType{AttrTypes: kawaiiVpcPeeringType}, vpc)
 }
 
-func kawaiiModelToResource(ctx *context.Context, r *sdk.Kawaii, d *KawaiiResourceModel) {
-	if r == nil {
+// user-specified zones are kept as-is (names or IDs), backend-elected ones are read back from virtual IPs
+func kawaiiModelToZones(r *sdk.Kawaii, d *KawaiiResourceModel) {
+	if !d.Zones.IsNull() && !d.Zones.IsUnknown() {
 		return
 	}
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
+	zones := []attr.Value{}
+	for _, z := range r.Netip.Zones {
+		zones = append(zones, types.StringValue(z.Zone))
+	}
+	d.Zones, _ = types.ListValue(types.StringType, zones)
+}
+
+// resolves Kawaii's requested zones IDs, if any
+func getKawaiiZoneIDs(ctx context.Context, data *KowabungaProviderData, d *KawaiiResourceModel) ([]string, error) {
+	ids := []string{}
+	if d.Zones.IsNull() || d.Zones.IsUnknown() {
+		return ids, nil
 	}
+	zones := []string{}
+	d.Zones.ElementsAs(ctx, &zones, false)
+	for _, z := range zones {
+		id, err := getZoneID(ctx, data, z)
+		if err != nil {
+			return ids, fmt.Errorf("%s: %s", err.Error(), z)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// fingerprints Kawaii enforced ruleset, leaving rules volatile counters apart
+func kawaiiModelToRulesetHash(r *sdk.Kawaii, d *KawaiiResourceModel) {
+	ingress := slices.Clone(r.Firewall.Ingress)
+	for i := range ingress {
+		ingress[i].Stats = nil
+	}
+	egress := slices.Clone(r.Firewall.Egress)
+	for i := range egress {
+		egress[i].Stats = nil
+	}
+	dnat := slices.Clone(r.Dnat)
+	for i := range dnat {
+		dnat[i].Stats = nil
+	}
+
+	ruleset, _ := json.Marshal(map[string]any{
+		KeyEgressPolicy: r.Firewall.EgressPolicy,
+		KeyLogPolicy:    r.Firewall.LogPolicy,
+		KeyIngressRules: ingress,
+		KeyEgressRules:  egress,
+		KeyNatRules:     dnat,
+		KeyVpcPeerings:  r.VpcPeerings,
+	})
+	d.RulesetHash = types.StringValue(fmt.Sprintf("%x", sha256.Sum256(ruleset)))
+}
+
+func kawaiiModelToResource(ctx *context.Context, r *sdk.Kawaii, d *KawaiiResourceModel, subnets map[string]string, sources map[string]string) {
+	if r == nil {
+		return
+	}
+	d.Desc = descModelToResource(r.Description)
 
 	kawaiiModelToNetworkConfig(ctx, r, d)
-	kawaiiModelToFirewall(ctx, r, d)
+	kawaiiModelToZones(r, d)
+	kawaiiModelToFirewall(ctx, r, d, sources)
 	kawaiiModelToNatRules(ctx, r, d)
-	kawaiiModelToVpcPeerings(ctx, r, d)
+	// unset VPC peerings, e.g. upon import, are left unmanaged (i.e. to standalone kawaii_vpc_peering resources)
+	if !d.VpcPeerings.IsNull() {
+		kawaiiModelToVpcPeerings(ctx, r, d, subnets)
+	}
+	kawaiiModelToRulesetHash(r, d)
 }
 
 func (r *KawaiiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -920,7 +2097,7 @@ func (r *KawaiiResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -934,25 +2111,59 @@ func (r *KawaiiResource) Create(ctx context.Context, req resource.CreateRequest,
 	// find parent project
 	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
 		return
 	}
 	// find parent region
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+		return
+	}
+	// find peered subnets
+	subnets, err := getKawaiiVpcPeeringsSubnetIDs(ctx, r.Data, data)
+	if err != nil {
+		errorCreateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+		return
+	}
+	// find ingress rules subnets sources
+	sources, err := getKawaiiIngressSourcesCIDRs(ctx, r.Data, data)
+	if err != nil {
+		errorCreateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+		return
+	}
+	// find requested zones (optional)
+	zones, err := getKawaiiZoneIDs(ctx, r.Data, data)
+	if err != nil {
+		errorCreateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+		return
+	}
+	// ensure NAT rules are forwarded to reachable addresses
+	err = checkKawaiiNatDestinations(ctx, r.Data, data, projectId, subnets, &resp.Diagnostics)
+	if err != nil {
+		errorCreateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
 		return
 	}
-	m := kawaiiResourceToModel(&ctx, data)
+	m := kawaiiResourceToModel(&ctx, data, subnets, sources)
 
 	// create a new Kawaii
-	kawaii, _, err := r.Data.K.ProjectAPI.CreateProjectRegionKawaii(ctx, projectId, regionId).Kawaii(m).Execute()
+	api := r.Data.K.ProjectAPI.CreateProjectRegionKawaii(ctx, projectId, regionId).Kawaii(m)
+	if len(zones) > 0 {
+		api = api.Zones(zones)
+	}
+	kawaii, _, err := api.Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
 		return
 	}
 	data.ID = types.StringPointerValue(kawaii.Id)
-	kawaiiModelToResource(&ctx, kawaii, data) // read back resulting object
+	kawaii = resourceWaitConsistent(ctx, KawaiiResourceName, kawaiiDisplayName(data), kawaii, func() (*sdk.Kawaii, error) {
+		k, _, err := r.Data.K.KawaiiAPI.ReadKawaii(ctx, *kawaii.Id).Execute()
+		return k, err
+	}, func(k *sdk.Kawaii) bool {
+		return len(k.Netip.Public) > 0
+	})
+	kawaiiModelToResource(&ctx, kawaii, data, subnets, sources) // read back resulting object
 	tflog.Trace(ctx, "created Kawaii resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -964,7 +2175,7 @@ func (r *KawaiiResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -977,11 +2188,17 @@ func (r *KawaiiResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	kawaii, _, err := r.Data.K.KawaiiAPI.ReadKawaii(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
 		return
 	}
 
-	kawaiiModelToResource(&ctx, kawaii, data)
+	subnets, _ := getKawaiiVpcPeeringsSubnetIDs(ctx, r.Data, data)
+	sources, _ := getKawaiiIngressSourcesCIDRs(ctx, r.Data, data)
+	kawaiiModelToResource(&ctx, kawaii, data, subnets, sources)
+	// plan-time only setting, unset on import
+	if data.ValidateRules.IsNull() {
+		data.ValidateRules = types.BoolValue(KawaiiDefaultValueValidateRules)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -992,7 +2209,7 @@ func (r *KawaiiResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1003,12 +2220,54 @@ func (r *KawaiiResource) Update(ctx context.Context, req resource.UpdateRequest,
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := kawaiiResourceToModel(&ctx, data)
-	_, _, err := r.Data.K.KawaiiAPI.UpdateKawaii(ctx, data.ID.ValueString()).Kawaii(m).Execute()
+	subnets, err := getKawaiiVpcPeeringsSubnetIDs(ctx, r.Data, data)
+	if err != nil {
+		errorUpdateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+		return
+	}
+	sources, err := getKawaiiIngressSourcesCIDRs(ctx, r.Data, data)
+	if err != nil {
+		errorUpdateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+		return
+	}
+	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
+	if err != nil {
+		errorUpdateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+		return
+	}
+	// VPC peerings which have never been declared inline are left as-is
+	var priorPeerings types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(KeyVpcPeerings), &priorPeerings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var unmanagedPeerings []sdk.KawaiiVpcPeering
+	if data.VpcPeerings.IsNull() && priorPeerings.IsNull() {
+		current, _, err := r.Data.K.KawaiiAPI.ReadKawaii(ctx, data.ID.ValueString()).Execute()
+		if err != nil {
+			errorUpdateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+			return
+		}
+		unmanagedPeerings = current.VpcPeerings
+		for _, p := range unmanagedPeerings {
+			subnets[p.Subnet] = p.Subnet
+		}
+	}
+	err = checkKawaiiNatDestinations(ctx, r.Data, data, projectId, subnets, &resp.Diagnostics)
+	if err != nil {
+		errorUpdateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
+		return
+	}
+	m := kawaiiResourceToModel(&ctx, data, subnets, sources)
+	if unmanagedPeerings != nil {
+		m.VpcPeerings = unmanagedPeerings
+	}
+	kawaii, _, err := r.Data.K.KawaiiAPI.UpdateKawaii(ctx, data.ID.ValueString()).Kawaii(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
 		return
 	}
+	kawaiiModelToResource(&ctx, kawaii, data, subnets, sources) // read back resulting object, including rules counters
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -1020,7 +2279,7 @@ func (r *KawaiiResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1033,7 +2292,7 @@ func (r *KawaiiResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	_, err := r.Data.K.KawaiiAPI.DeleteKawaii(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, KawaiiResourceName, kawaiiDisplayName(data))
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
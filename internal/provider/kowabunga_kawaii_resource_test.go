@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// returns a Kawaii configuration where all attributes are null, but the
+// specified ones
+func testKawaiiConfig(t *testing.T, attrs map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	NewKawaiiResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for k, v := range objType.AttributeTypes {
+		values[k] = tftypes.NewValue(v, nil)
+	}
+	for k, v := range attrs {
+		values[k] = v
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objType, values),
+	}
+}
+
+// returns a set of rules for the specified Kawaii attribute, where each rule
+// attributes are null, but the specified ones
+func testKawaiiRules(t *testing.T, key string, rules ...map[string]string) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	NewKawaiiResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	setType := schemaResp.Schema.Attributes[key].GetType().TerraformType(ctx).(tftypes.Set)
+	ruleType := setType.ElementType.(tftypes.Object)
+
+	elems := []tftypes.Value{}
+	for _, rule := range rules {
+		values := map[string]tftypes.Value{}
+		for k, v := range ruleType.AttributeTypes {
+			values[k] = tftypes.NewValue(v, nil)
+		}
+		for k, v := range rule {
+			values[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		elems = append(elems, tftypes.NewValue(ruleType, values))
+	}
+
+	return tftypes.NewValue(setType, elems)
+}
+
+func TestKawaiiResourceValidateConfigIngressPorts(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol string
+		ports    string
+		wantErr  bool
+	}{
+		{name: "icmp without ports", protocol: "icmp", ports: "", wantErr: false},
+		{name: "icmp uppercase without ports", protocol: "ICMP", ports: "", wantErr: false},
+		{name: "icmp with ports", protocol: "icmp", ports: "80", wantErr: true},
+		{name: "tcp without ports", protocol: "tcp", ports: "", wantErr: true},
+		{name: "tcp with ports", protocol: "tcp", ports: "80", wantErr: false},
+		{name: "udp with ports", protocol: "udp", ports: "53", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := testKawaiiRules(t, KeyIngressRules, map[string]string{
+				KeySource:   "0.0.0.0/0",
+				KeyProtocol: tt.protocol,
+				KeyPorts:    tt.ports,
+			})
+			req := resource.ValidateConfigRequest{
+				Config: testKawaiiConfig(t, map[string]tftypes.Value{KeyIngressRules: rules}),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r := &KawaiiResource{}
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateConfig() errors = %v, want error %t", resp.Diagnostics.Errors(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKawaiiLintAddressMatch(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		overlaps bool
+		covers   bool
+	}{
+		{a: "0.0.0.0/0", b: "10.0.0.0/8", overlaps: true, covers: true},
+		{a: "10.0.0.0/8", b: "0.0.0.0/0", overlaps: true, covers: false},
+		{a: "::/0", b: "2001:db8::/32", overlaps: true, covers: true},
+		{a: "2001:db8::/32", b: "::/0", overlaps: true, covers: false},
+		{a: "2001:db8::/32", b: "2001:db8::1", overlaps: true, covers: true},
+		{a: "2001:db8::/32", b: "2001:db9::/32", overlaps: false, covers: false},
+		{a: "0.0.0.0/0", b: "::/0", overlaps: false, covers: false},
+		{a: "::/0", b: "10.0.0.1", overlaps: false, covers: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			overlaps, covers := kawaiiLintAddressMatch(tt.a, tt.b)
+			if overlaps != tt.overlaps || covers != tt.covers {
+				t.Errorf("kawaiiLintAddressMatch(%q, %q) = (%t, %t), want (%t, %t)", tt.a, tt.b, overlaps, covers, tt.overlaps, tt.covers)
+			}
+		})
+	}
+}
+
+func testPtr[T any](v T) *T {
+	return &v
+}
+
+func TestKawaiiRuleKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{
+			name:  "reordered ports",
+			a:     kawaiiRuleKey("tcp", "80,443", 0, "0.0.0.0/0"),
+			b:     kawaiiRuleKey("tcp", "443,80", 0, "0.0.0.0/0"),
+			equal: true,
+		},
+		{
+			name:  "protocol case",
+			a:     kawaiiRuleKey("TCP", "22", 0, "10.0.0.0/8"),
+			b:     kawaiiRuleKey("tcp", "22", 0, "10.0.0.0/8"),
+			equal: true,
+		},
+		{
+			name:  "distinct priorities",
+			a:     kawaiiRuleKey("tcp", "22", 0, "10.0.0.0/8"),
+			b:     kawaiiRuleKey("tcp", "22", 10, "10.0.0.0/8"),
+			equal: false,
+		},
+		{
+			name:  "distinct peers",
+			a:     kawaiiRuleKey("udp", "53", 0, "0.0.0.0/0", "10.0.0.1"),
+			b:     kawaiiRuleKey("udp", "53", 0, "0.0.0.0/0", "10.0.0.2"),
+			equal: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.a == tt.b) != tt.equal {
+				t.Errorf("kawaiiRuleKey() = %q and %q, want equal %t", tt.a, tt.b, tt.equal)
+			}
+		})
+	}
+}
+
+func TestKawaiiRulesMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		declared []string
+		actual   []string
+		want     [][2]int
+	}{
+		{
+			name:     "same order",
+			declared: []string{"a", "b", "c"},
+			actual:   []string{"a", "b", "c"},
+			want:     [][2]int{{0, 0}, {1, 1}, {2, 2}},
+		},
+		{
+			name:     "reordered",
+			declared: []string{"c", "a", "b"},
+			actual:   []string{"a", "b", "c"},
+			want:     [][2]int{{2, 0}, {0, 1}, {1, 2}},
+		},
+		{
+			name:     "duplicated keys",
+			declared: []string{"b", "a", "b"},
+			actual:   []string{"a", "b", "b"},
+			want:     [][2]int{{1, 0}, {0, 1}, {2, 2}},
+		},
+		{
+			name:     "undeclared rule",
+			declared: []string{"b"},
+			actual:   []string{"a", "b"},
+			want:     [][2]int{{1, 0}, {0, -1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kawaiiRulesMatch(tt.declared, tt.actual); !slices.Equal(got, tt.want) {
+				t.Errorf("kawaiiRulesMatch(%v, %v) = %v, want %v", tt.declared, tt.actual, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKawaiiSortIngressRules(t *testing.T) {
+	ssh := sdk.KawaiiFirewallIngressRule{Source: testPtr("10.0.0.0/8"), Protocol: testPtr("tcp"), Ports: "22"}
+	web := sdk.KawaiiFirewallIngressRule{Protocol: testPtr("tcp"), Ports: "80,443"}
+	webReordered := sdk.KawaiiFirewallIngressRule{Protocol: testPtr("tcp"), Ports: "443,80"}
+	ping := sdk.KawaiiFirewallIngressRule{Protocol: testPtr("icmp")}
+	late := sdk.KawaiiFirewallIngressRule{Protocol: testPtr("udp"), Ports: "53", Priority: testPtr[int64](10)}
+
+	a := []sdk.KawaiiFirewallIngressRule{late, web, ssh, ping}
+	b := []sdk.KawaiiFirewallIngressRule{ping, ssh, late, webReordered}
+	kawaiiSortIngressRules(a)
+	kawaiiSortIngressRules(b)
+
+	for i := range a {
+		if kawaiiIngressRuleKey(a[i]) != kawaiiIngressRuleKey(b[i]) {
+			t.Errorf("rule #%d: got %q and %q, want same rule whatever the declaration order", i, kawaiiIngressRuleKey(a[i]), kawaiiIngressRuleKey(b[i]))
+		}
+	}
+	if kawaiiIngressRuleKey(a[len(a)-1]) != kawaiiIngressRuleKey(late) {
+		t.Errorf("last rule = %q, want highest priority one %q", kawaiiIngressRuleKey(a[len(a)-1]), kawaiiIngressRuleKey(late))
+	}
+}
+
+func TestKawaiiSortEgressRules(t *testing.T) {
+	dns := sdk.KawaiiFirewallEgressRule{Destination: testPtr("10.0.0.1"), Protocol: testPtr("udp"), Ports: "53"}
+	web := sdk.KawaiiFirewallEgressRule{Protocol: testPtr("tcp"), Ports: "80,443", Priority: testPtr[int64](5)}
+	first := sdk.KawaiiFirewallEgressRule{Destination: testPtr("2001:db8::/32"), Protocol: testPtr("tcp"), Ports: "22", Priority: testPtr[int64](-1)}
+
+	a := []sdk.KawaiiFirewallEgressRule{web, dns, first}
+	b := []sdk.KawaiiFirewallEgressRule{first, web, dns}
+	kawaiiSortEgressRules(a)
+	kawaiiSortEgressRules(b)
+
+	want := []string{kawaiiEgressRuleKey(first), kawaiiEgressRuleKey(dns), kawaiiEgressRuleKey(web)}
+	for i := range want {
+		if kawaiiEgressRuleKey(a[i]) != want[i] || kawaiiEgressRuleKey(b[i]) != want[i] {
+			t.Errorf("rule #%d: got %q and %q, want %q", i, kawaiiEgressRuleKey(a[i]), kawaiiEgressRuleKey(b[i]), want[i])
+		}
+	}
+}
+
+func TestKawaiiSortNatRules(t *testing.T) {
+	web := sdk.KawaiiDNatRule{Destination: "10.0.0.10", Protocol: testPtr("tcp"), Ports: "80,443"}
+	webReordered := sdk.KawaiiDNatRule{Destination: "10.0.0.10", Protocol: testPtr("tcp"), Ports: "443,80"}
+	dns := sdk.KawaiiDNatRule{Destination: "10.0.0.20", Protocol: testPtr("udp"), Ports: "53"}
+
+	a := []sdk.KawaiiDNatRule{web, dns}
+	b := []sdk.KawaiiDNatRule{dns, webReordered}
+	kawaiiSortNatRules(a)
+	kawaiiSortNatRules(b)
+
+	for i := range a {
+		if kawaiiNatRuleKey(a[i]) != kawaiiNatRuleKey(b[i]) {
+			t.Errorf("rule #%d: got %q and %q, want same rule whatever the declaration order", i, kawaiiNatRuleKey(a[i]), kawaiiNatRuleKey(b[i]))
+		}
+	}
+}
+
+// returns a single egress rule set, whose action is set to the specified value
+func testKawaiiEgressRules(t *testing.T, action tftypes.Value) types.Set {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	NewKawaiiResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	setAttr := schemaResp.Schema.Attributes[KeyEgressRules]
+	setType := setAttr.GetType().TerraformType(ctx).(tftypes.Set)
+	ruleType := setType.ElementType.(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for k, v := range ruleType.AttributeTypes {
+		values[k] = tftypes.NewValue(v, nil)
+	}
+	values[KeyAction] = action
+	values[KeyPorts] = tftypes.NewValue(tftypes.String, "443")
+
+	v, err := setAttr.GetType().ValueFromTerraform(ctx, tftypes.NewValue(setType, []tftypes.Value{tftypes.NewValue(ruleType, values)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return v.(types.Set)
+}
+
+func TestKawaiiPlanEgressRulesAction(t *testing.T) {
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	tests := []struct {
+		name   string
+		policy string
+		config tftypes.Value
+		plan   tftypes.Value
+		want   string
+	}{
+		{name: "accept policy", policy: KawaiiPolicyAccept, config: tftypes.NewValue(tftypes.String, nil), plan: unknown, want: KawaiiPolicyDrop},
+		{name: "drop policy", policy: KawaiiPolicyDrop, config: tftypes.NewValue(tftypes.String, nil), plan: unknown, want: KawaiiPolicyAccept},
+		{name: "explicit action", policy: KawaiiPolicyAccept, config: tftypes.NewValue(tftypes.String, KawaiiPolicyAccept), plan: tftypes.NewValue(tftypes.String, KawaiiPolicyAccept), want: KawaiiPolicyAccept},
+		{name: "unknown action", policy: KawaiiPolicyAccept, config: unknown, plan: unknown, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			config := &KawaiiResourceModel{EgressRules: testKawaiiEgressRules(t, tt.config)}
+			plan := &KawaiiResourceModel{
+				EgressPolicy: types.StringValue(tt.policy),
+				EgressRules:  testKawaiiEgressRules(t, tt.plan),
+			}
+
+			rules, diags := kawaiiPlanEgressRulesAction(ctx, config, plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			action := rules.Elements()[0].(types.Object).Attributes()[KeyAction].(types.String)
+			if tt.want == "" {
+				if !action.IsUnknown() {
+					t.Errorf("action = %s, want unknown", action)
+				}
+				return
+			}
+			if action.ValueString() != tt.want {
+				t.Errorf("action = %s, want %q", action, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	KawaiiVpcPeeringResourceName = "kawaii_vpc_peering"
+
+	KawaiiVpcPeeringErrExists  = "subnet is already peered with Kawaii"
+	KawaiiVpcPeeringErrMissing = "subnet is no longer peered with Kawaii"
+)
+
+var _ resource.Resource = &KawaiiVpcPeeringResource{}
+var _ resource.ResourceWithImportState = &KawaiiVpcPeeringResource{}
+
+func NewKawaiiVpcPeeringResource() resource.Resource {
+	return &KawaiiVpcPeeringResource{}
+}
+
+type KawaiiVpcPeeringResource struct {
+	Data *KowabungaProviderData
+}
+
+type KawaiiVpcPeeringResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Kawaii       types.String `tfsdk:"kawaii"`
+	Subnet       types.String `tfsdk:"subnet"`
+	Policy       types.String `tfsdk:"policy"`
+	IngressRules types.List   `tfsdk:"ingress_rules"` // KawaiiForwardRule
+	EgressRules  types.List   `tfsdk:"egress_rules"`  // KawaiiForwardRule
+	NetworkCfg   types.List   `tfsdk:"netcfg"`        // KawaiiVpcPeeringNetworkZoneConfig, read-only
+}
+
+// VPC peering is unnamed, identified by its parent Kawaii and peered subnet
+func kawaiiVpcPeeringDisplayName(d *KawaiiVpcPeeringResourceModel) string {
+	return d.Kawaii.ValueString() + "/" + d.Subnet.ValueString()
+}
+
+func (r *KawaiiVpcPeeringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resourceMetadata(req, resp, KawaiiVpcPeeringResourceName)
+}
+
+func (r *KawaiiVpcPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// VPC peering is identified by its peered subnet ID
+	resourceImportStateComposite(ctx, req, resp, KeyKawaii)
+}
+
+func (r *KawaiiVpcPeeringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Data = resourceConfigure(req, resp)
+}
+
+func (r *KawaiiVpcPeeringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// shares Kawaii's inline VPC peering schema
+	kawaii := &KawaiiResource{}
+	peering := kawaii.SchemaVpcPeerings().NestedObject.Attributes
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single Kawaii private VPC subnet peering, with its own forwarding rules, independently of the Kawaii resource. Should not be used along with the Kawaii resource's own **vpc_peerings** attribute. Only source of truth for peerings of imported Kawaii, which never read them back.",
+		Attributes: map[string]schema.Attribute{
+			KeyKawaii: schema.StringAttribute{
+				MarkdownDescription: "Associated Kawaii ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeySubnet: schema.StringAttribute{
+				MarkdownDescription: "Kowabunga Subnet name or ID to be peered with (subnet local IP addresses will be automatically assigned to Kawaii instances). Changing it forces VPC peering re-creation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyPolicy:        peering[KeyPolicy],
+			KeyIngressRules:  peering[KeyIngressRules],
+			KeyEgressRules:   peering[KeyEgressRules],
+			KeyNetworkConfig: peering[KeyNetworkConfig],
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, resourceAttributesWithoutName(&ctx))
+	// VPC peering holds no description of its own
+	delete(resp.Schema.Attributes, KeyDesc)
+}
+
+// wraps VPC peering as a single-element Kawaii peering list, for conversion
+func kawaiiVpcPeeringToKawaii(ctx *context.Context, d *KawaiiVpcPeeringResourceModel) *KawaiiResourceModel {
+	vp := KawaiiVpcPeering{
+		Subnet:       d.Subnet,
+		Policy:       d.Policy,
+		IngressRules: d.IngressRules,
+		EgressRules:  d.EgressRules,
+		NetworkCfg:   d.NetworkCfg,
+	}
+	object, diags := types.ObjectValueFrom(*ctx, kawaiiVpcPeeringType, vp)
+	if diags.HasError() {
+		for _, err := range diags.Errors() {
+			tflog.Error(*ctx, err.Detail())
+		}
+	}
+	peerings, _ := types.ListValue(types.ObjectType{AttrTypes: kawaiiVpcPeeringType}, []attr.Value{object})
+
+	return &KawaiiResourceModel{
+		VpcPeerings: peerings,
+	}
+}
+
+func kawaiiVpcPeeringResourceToModel(ctx *context.Context, d *KawaiiVpcPeeringResourceModel, subnetId string) sdk.KawaiiVpcPeering {
+	subnets := map[string]string{
+		d.Subnet.ValueString(): subnetId,
+	}
+	return kawaiiVpcPeeringsModel(ctx, kawaiiVpcPeeringToKawaii(ctx, d), subnets)[0]
+}
+
+func kawaiiVpcPeeringModelToResource(ctx *context.Context, r *sdk.KawaiiVpcPeering, d *KawaiiVpcPeeringResourceModel) {
+	if r == nil {
+		return
+	}
+
+	k := kawaiiVpcPeeringToKawaii(ctx, d)
+	subnets := map[string]string{
+		d.Subnet.ValueString(): r.Subnet,
+	}
+	kawaiiModelToVpcPeerings(ctx, &sdk.Kawaii{VpcPeerings: []sdk.KawaiiVpcPeering{*r}}, k, subnets)
+
+	peerings := make([]types.Object, 0, len(k.VpcPeerings.Elements()))
+	diags := k.VpcPeerings.ElementsAs(*ctx, &peerings, false)
+	if diags.HasError() || len(peerings) == 0 {
+		for _, err := range diags.Errors() {
+			tflog.Error(*ctx, err.Detail())
+		}
+		return
+	}
+	vp := KawaiiVpcPeering{}
+	diags = peerings[0].As(*ctx, &vp, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})
+	if diags.HasError() {
+		for _, err := range diags.Errors() {
+			tflog.Error(*ctx, err.Detail())
+		}
+	}
+
+	d.Subnet = vp.Subnet
+	d.Policy = vp.Policy
+	d.IngressRules = vp.IngressRules
+	d.EgressRules = vp.EgressRules
+	d.NetworkCfg = vp.NetworkCfg
+}
+
+// updates Kawaii's VPC peerings list, leaving the rest of its configuration untouched
+func kawaiiVpcPeeringsUpdate(ctx context.Context, data *KowabungaProviderData, kawaiiId string, update func([]sdk.KawaiiVpcPeering) ([]sdk.KawaiiVpcPeering, error)) (*sdk.Kawaii, error) {
+	kawaii, _, err := data.K.KawaiiAPI.ReadKawaii(ctx, kawaiiId).Execute()
+	if err != nil {
+		return nil, err
+	}
+	peerings, err := update(kawaii.VpcPeerings)
+	if err != nil {
+		return nil, err
+	}
+
+	m := sdk.Kawaii{
+		Description: kawaii.Description,
+		Firewall:    kawaii.Firewall,
+		Dnat:        kawaii.Dnat,
+		VpcPeerings: peerings,
+	}
+	kawaii, _, err = data.K.KawaiiAPI.UpdateKawaii(ctx, kawaiiId).Kawaii(m).Execute()
+	return kawaii, err
+}
+
+func kawaiiVpcPeeringFind(kawaii *sdk.Kawaii, subnetId string) *sdk.KawaiiVpcPeering {
+	idx := slices.IndexFunc(kawaii.VpcPeerings, func(p sdk.KawaiiVpcPeering) bool {
+		return p.Subnet == subnetId
+	})
+	if idx < 0 {
+		return nil
+	}
+	return &kawaii.VpcPeerings[idx]
+}
+
+func (r *KawaiiVpcPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *KawaiiVpcPeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	// find parent Kawaii
+	kawaiiId, err := getKawaiiID(ctx, r.Data, data.Kawaii.ValueString())
+	if err != nil {
+		errorCreateGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+	// find peered subnet
+	subnetId, err := getSubnetID(ctx, r.Data, data.Subnet.ValueString())
+	if err != nil {
+		errorCreateGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+
+	m := kawaiiVpcPeeringResourceToModel(&ctx, data, subnetId)
+	kawaii, err := kawaiiVpcPeeringsUpdate(ctx, r.Data, kawaiiId, func(peerings []sdk.KawaiiVpcPeering) ([]sdk.KawaiiVpcPeering, error) {
+		if slices.ContainsFunc(peerings, func(p sdk.KawaiiVpcPeering) bool { return p.Subnet == subnetId }) {
+			return nil, fmt.Errorf("%s: %s", KawaiiVpcPeeringErrExists, data.Subnet.ValueString())
+		}
+		return append(peerings, m), nil
+	})
+	if err != nil {
+		errorCreateGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+	data.ID = types.StringValue(subnetId)
+	kawaiiVpcPeeringModelToResource(&ctx, kawaiiVpcPeeringFind(kawaii, subnetId), data) // read back resulting object, including per-zone network config
+	tflog.Trace(ctx, "created Kawaii VPC peering resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KawaiiVpcPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *KawaiiVpcPeeringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	kawaiiId, err := getKawaiiID(ctx, r.Data, data.Kawaii.ValueString())
+	if err != nil {
+		errorReadGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+	kawaii, _, err := r.Data.K.KawaiiAPI.ReadKawaii(ctx, kawaiiId).Execute()
+	if err != nil {
+		errorReadGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+	peering := kawaiiVpcPeeringFind(kawaii, data.ID.ValueString())
+	if peering == nil {
+		errorReadGeneric(resp, fmt.Errorf("%s: %s", KawaiiVpcPeeringErrMissing, data.ID.ValueString()), KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+
+	kawaiiVpcPeeringModelToResource(&ctx, peering, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KawaiiVpcPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *KawaiiVpcPeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	kawaiiId, err := getKawaiiID(ctx, r.Data, data.Kawaii.ValueString())
+	if err != nil {
+		errorUpdateGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+
+	subnetId := data.ID.ValueString()
+	m := kawaiiVpcPeeringResourceToModel(&ctx, data, subnetId)
+	kawaii, err := kawaiiVpcPeeringsUpdate(ctx, r.Data, kawaiiId, func(peerings []sdk.KawaiiVpcPeering) ([]sdk.KawaiiVpcPeering, error) {
+		idx := slices.IndexFunc(peerings, func(p sdk.KawaiiVpcPeering) bool { return p.Subnet == subnetId })
+		if idx < 0 {
+			return nil, fmt.Errorf("%s: %s", KawaiiVpcPeeringErrMissing, data.Subnet.ValueString())
+		}
+		peerings[idx] = m
+		return peerings, nil
+	})
+	if err != nil {
+		errorUpdateGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+	kawaiiVpcPeeringModelToResource(&ctx, kawaiiVpcPeeringFind(kawaii, subnetId), data) // read back resulting object
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KawaiiVpcPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *KawaiiVpcPeeringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	kawaiiId, err := getKawaiiID(ctx, r.Data, data.Kawaii.ValueString())
+	if err != nil {
+		errorDeleteGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+
+	subnetId := data.ID.ValueString()
+	_, err = kawaiiVpcPeeringsUpdate(ctx, r.Data, kawaiiId, func(peerings []sdk.KawaiiVpcPeering) ([]sdk.KawaiiVpcPeering, error) {
+		return slices.DeleteFunc(peerings, func(p sdk.KawaiiVpcPeering) bool { return p.Subnet == subnetId }), nil
+	})
+	// peering is gone along with its parent Kawaii
+	if err != nil && !apiErrorIsNotFound(err) {
+		errorDeleteGeneric(resp, err, KawaiiVpcPeeringResourceName, kawaiiVpcPeeringDisplayName(data))
+		return
+	}
+	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
+}
@@ -7,9 +7,10 @@ import (
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -43,7 +44,7 @@ func (r *KiwiResource) Metadata(ctx context.Context, req resource.MetadataReques
 }
 
 func (r *KiwiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, KeyRegion, getKiwiID)
 }
 
 func (r *KiwiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,9 +58,12 @@ func (r *KiwiResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyAgents: schema.ListAttribute{
-				MarkdownDescription: "The list of Kowabunga remote agents to be associated with the Kiwi network gateway",
+				MarkdownDescription: "The list of Kowabunga remote agents (name or ID) to be associated with the Kiwi network gateway",
 				ElementType:         types.StringType,
 				Required:            true,
 			},
@@ -69,35 +73,23 @@ func (r *KiwiResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 }
 
 // converts kiwi from Terraform model to Kowabunga API model
-func kiwiResourceToModel(d *KiwiResourceModel) sdk.Kiwi {
-
-	agents := []string{}
-	d.Agents.ElementsAs(context.TODO(), &agents, false)
-
+func kiwiResourceToModel(ctx *context.Context, d *KiwiResourceModel, agents map[string]string) sdk.Kiwi {
 	return sdk.Kiwi{
 		Name:        d.Name.ValueString(),
 		Description: d.Desc.ValueStringPointer(),
-		Agents:      agents,
+		Agents:      agentsResourceToModel(ctx, d.Agents, agents),
 	}
 }
 
 // converts kiwi from Kowabunga API model to Terraform model
-func kiwiModelToResource(r *sdk.Kiwi, d *KiwiResourceModel) {
+func kiwiModelToResource(r *sdk.Kiwi, d *KiwiResourceModel, agents map[string]string) {
 	if r == nil {
 		return
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
-	agents := []attr.Value{}
-	for _, a := range r.Agents {
-		agents = append(agents, types.StringValue(a))
-	}
-	d.Agents, _ = types.ListValue(types.StringType, agents)
+	d.Desc = descModelToResource(r.Description)
+	d.Agents = agentsModelToResource(r.Agents, agents)
 }
 
 func (r *KiwiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -107,7 +99,7 @@ func (r *KiwiResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -121,18 +113,24 @@ func (r *KiwiResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// find parent region
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KiwiResourceName, data.Name.ValueString())
+		return
+	}
+	// find associated agents
+	agents, err := getAgentIDs(ctx, r.Data, data.Agents)
+	if err != nil {
+		errorCreateGeneric(resp, err, KiwiResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new network gateway
-	m := kiwiResourceToModel(data)
+	m := kiwiResourceToModel(&ctx, data, agents)
 	kiwi, _, err := r.Data.K.RegionAPI.CreateKiwi(ctx, regionId).Kiwi(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KiwiResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(kiwi.Id)
-	kiwiModelToResource(kiwi, data) // read back resulting object
+	kiwiModelToResource(kiwi, data, agents) // read back resulting object
 	tflog.Trace(ctx, "created kiwi resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -144,7 +142,7 @@ func (r *KiwiResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -157,11 +155,13 @@ func (r *KiwiResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	kiwi, _, err := r.Data.K.KiwiAPI.ReadKiwi(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, KiwiResourceName, data.Name.ValueString())
 		return
 	}
 
-	kiwiModelToResource(kiwi, data)
+	// unresolvable agents are reported as drift
+	agents, _ := getAgentIDs(ctx, r.Data, data.Agents)
+	kiwiModelToResource(kiwi, data, agents)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -172,7 +172,7 @@ func (r *KiwiResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -183,10 +183,16 @@ func (r *KiwiResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := kiwiResourceToModel(data)
-	_, _, err := r.Data.K.KiwiAPI.UpdateKiwi(ctx, data.ID.ValueString()).Kiwi(m).Execute()
+	agents, err := getAgentIDs(ctx, r.Data, data.Agents)
+	if err != nil {
+		errorUpdateGeneric(resp, err, KiwiResourceName, data.Name.ValueString())
+		return
+	}
+
+	m := kiwiResourceToModel(&ctx, data, agents)
+	_, _, err = r.Data.K.KiwiAPI.UpdateKiwi(ctx, data.ID.ValueString()).Kiwi(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, KiwiResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -200,7 +206,7 @@ func (r *KiwiResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -213,7 +219,7 @@ func (r *KiwiResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	_, err := r.Data.K.KiwiAPI.DeleteKiwi(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, KiwiResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
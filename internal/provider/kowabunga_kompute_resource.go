@@ -11,9 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -54,6 +54,10 @@ type KomputeResourceModel struct {
 	ExtraDisk types.Int64    `tfsdk:"extra_disk"`
 	Public    types.Bool     `tfsdk:"public"`
 	IP        types.String   `tfsdk:"ip"`
+	Notify    types.Bool     `tfsdk:"notify"`
+	// read-only
+	Ready         types.Bool   `tfsdk:"ready"`
+	HealthMessage types.String `tfsdk:"health_message"`
 }
 
 func (r *KomputeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,7 +65,7 @@ func (r *KomputeResource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *KomputeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateComposite(ctx, req, resp, KeyProject, KeyZone)
 	resource.ImportStatePassthroughID(ctx, path.Root(KeyIP), req, resp)
 }
 
@@ -71,27 +75,39 @@ func (r *KomputeResource) Configure(ctx context.Context, req resource.ConfigureR
 
 func (r *KomputeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Kompute virtual machine resource. **Kompute** is an seamless automated way to create virtual machine resources. It abstract the complexity of manually creating instance, volumes and network adapters resources and binding them together. It is the **RECOMMENDED** way to create and manipulate virtual machine services, unless a specific hwardware configuration is required. Kompute provides 2 network adapters, a public (WAN) and a private (LAN/VPC) one, as well as up to two disks (first one for OS, optional second one for extra data).",
+		MarkdownDescription: "Manages a Kompute virtual machine resource. **Kompute** is an seamless automated way to create virtual machine resources. It abstract the complexity of manually creating instance, volumes and network adapters resources and binding them together. It is the **RECOMMENDED** way to create and manipulate virtual machine services, unless a specific hwardware configuration is required. Kompute provides 2 network adapters, a public (WAN) and a private (LAN/VPC) one, as well as up to two disks (first one for OS, optional second one for extra data). Only name, description, vCPUs, memory and disks sizes can be updated in-place, changing any other argument forces the Kompute to be re-created.",
 		Attributes: map[string]schema.Attribute{
 			KeyProject: schema.StringAttribute{
 				MarkdownDescription: "Associated project name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyZone: schema.StringAttribute{
 				MarkdownDescription: "Associated zone name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyPool: schema.StringAttribute{
-				MarkdownDescription: "Associated storage pool name or ID (zone's default if unspecified)",
+				MarkdownDescription: "Associated storage pool name or ID (zone's default if unspecified). Changing it forces Kompute re-creation.",
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString(KomputeDefaultValuePool),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			KeyTemplate: schema.StringAttribute{
-				MarkdownDescription: "Associated template name or ID (zone's default storage pool's default if unspecified)",
+				MarkdownDescription: "Associated template name or ID (zone's default storage pool's default if unspecified). Changing it forces Kompute re-creation.",
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString(KomputeDefaultValueTemplate),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			KeyVCPUs: schema.Int64Attribute{
 				MarkdownDescription: "The Kompute instance number of vCPUs",
@@ -112,11 +128,15 @@ func (r *KomputeResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:             int64default.StaticInt64(KomputeDefaultValueExtraDisk),
 			},
 			KeyPublic: schema.BoolAttribute{
-				MarkdownDescription: "Should Kompute instance be exposed over public Internet ? (default: **false**)",
+				MarkdownDescription: "Should Kompute instance be exposed over public Internet ? (default: **false**). Changing it forces Kompute re-creation.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(KomputeDefaultValuePublic),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
+			KeyNotify: resourceAttributeNotify("Kompute"),
 			KeyIP: schema.StringAttribute{
 				MarkdownDescription: "IP (read-only)",
 				Computed:            true,
@@ -124,6 +144,14 @@ func (r *KomputeResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			KeyReady: schema.BoolAttribute{
+				MarkdownDescription: "Whether Kompute virtual machine is reported as running by backend (read-only)",
+				Computed:            true,
+			},
+			KeyHealthMessage: schema.StringAttribute{
+				MarkdownDescription: "Kompute virtual machine state, as reported by backend, or the reason why it couldn't be retrieved (read-only)",
+				Computed:            true,
+			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
@@ -160,11 +188,7 @@ func komputeModelToResource(r *sdk.Kompute, d *KomputeResourceModel) {
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	d.VCPUs = types.Int64Value(r.Vcpus)
 	d.Memory = types.Int64Value(memSize)
 	d.Disk = types.Int64Value(diskSize)
@@ -174,6 +198,26 @@ func komputeModelToResource(r *sdk.Kompute, d *KomputeResourceModel) {
 	} else {
 		d.IP = types.StringValue("")
 	}
+
+	// storage pool and template are creation-time only parameters, not exposed back by API
+	if d.Pool.IsNull() || d.Pool.IsUnknown() {
+		d.Pool = types.StringValue(KomputeDefaultValuePool)
+	}
+	if d.Template.IsNull() || d.Template.IsUnknown() {
+		d.Template = types.StringValue(KomputeDefaultValueTemplate)
+	}
+}
+
+// retrieves Kompute virtual machine backend state
+func komputeHealth(ctx context.Context, data *KowabungaProviderData, d *KomputeResourceModel) {
+	state, _, err := data.K.KomputeAPI.ReadKomputeState(ctx, d.ID.ValueString()).Execute()
+	if err != nil {
+		d.Ready = types.BoolValue(false)
+		d.HealthMessage = types.StringValue(err.Error())
+		return
+	}
+	d.Ready = types.BoolValue(state.State == ProgressStateRunning)
+	d.HealthMessage = types.StringValue(state.State)
 }
 
 func (r *KomputeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -183,7 +227,7 @@ func (r *KomputeResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -197,13 +241,13 @@ func (r *KomputeResource) Create(ctx context.Context, req resource.CreateRequest
 	// find parent project
 	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KomputeResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent zone
 	zoneId, err := getZoneID(ctx, r.Data, data.Zone.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KomputeResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent pool (optional)
@@ -214,20 +258,37 @@ func (r *KomputeResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// create a new Kompute
 	m := komputeResourceToModel(data)
-	api := r.Data.K.ProjectAPI.CreateProjectZoneKompute(ctx, projectId, zoneId).Kompute(m).Public(data.Public.ValueBool())
+	data.Notify = resourceNotify(r.Data, data.Notify)
+	api := r.Data.K.ProjectAPI.CreateProjectZoneKompute(ctx, projectId, zoneId).Kompute(m).Public(data.Public.ValueBool()).Notify(data.Notify.ValueBool())
 	if poolId != "" {
 		api = api.PoolId(poolId)
 	}
 	if templateId != "" {
 		api = api.TemplateId(templateId)
 	}
+	stop := resourceProgressStart(ctx, "creating", KomputeResourceName, data.Name.ValueString())
 	kompute, _, err := api.Execute()
+	stop()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KomputeResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(kompute.Id)
+	resourceWaitState(ctx, KomputeResourceName, data.Name.ValueString(), ProgressStateRunning, func() (string, error) {
+		state, _, err := r.Data.K.KomputeAPI.ReadKomputeState(ctx, *kompute.Id).Execute()
+		if err != nil {
+			return "", err
+		}
+		return state.State, nil
+	})
+	kompute = resourceWaitConsistent(ctx, KomputeResourceName, data.Name.ValueString(), kompute, func() (*sdk.Kompute, error) {
+		k, _, err := r.Data.K.KomputeAPI.ReadKompute(ctx, *kompute.Id).Execute()
+		return k, err
+	}, func(k *sdk.Kompute) bool {
+		return k.Ip != nil && *k.Ip != ""
+	})
 	komputeModelToResource(kompute, data) // read back resulting object
+	komputeHealth(ctx, r.Data, data)
 	tflog.Trace(ctx, "created Kompute resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -238,7 +299,7 @@ func (r *KomputeResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -251,11 +312,12 @@ func (r *KomputeResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	kompute, _, err := r.Data.K.KomputeAPI.ReadKompute(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, KomputeResourceName, data.Name.ValueString())
 		return
 	}
 
 	komputeModelToResource(kompute, data)
+	komputeHealth(ctx, r.Data, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -265,7 +327,7 @@ func (r *KomputeResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -279,9 +341,11 @@ func (r *KomputeResource) Update(ctx context.Context, req resource.UpdateRequest
 	m := komputeResourceToModel(data)
 	_, _, err := r.Data.K.KomputeAPI.UpdateKompute(ctx, data.ID.ValueString()).Kompute(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, KomputeResourceName, data.Name.ValueString())
 		return
 	}
+	data.Notify = resourceNotify(r.Data, data.Notify)
+	komputeHealth(ctx, r.Data, data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -292,7 +356,7 @@ func (r *KomputeResource) Delete(ctx context.Context, req resource.DeleteRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -305,7 +369,7 @@ func (r *KomputeResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	_, err := r.Data.K.KomputeAPI.DeleteKompute(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, KomputeResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -65,7 +65,7 @@ func (r *KonveyResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *KonveyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateComposite(ctx, req, resp, KeyProject, KeyRegion)
 }
 
 func (r *KonveyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -125,10 +125,16 @@ func (r *KonveyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			KeyProject: schema.StringAttribute{
 				MarkdownDescription: "Associated project name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyPrivateIP: schema.StringAttribute{
 				MarkdownDescription: "Konvey assigned private virtual IP address (read-only).",
@@ -269,11 +275,7 @@ func konveyModelToResource(ctx *context.Context, r *sdk.Konvey, d *KonveyResourc
 		d.Name = types.StringValue("")
 	}
 
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 
 	if r.Vip != nil {
 		d.PrivateIP = types.StringPointerValue(r.Vip)
@@ -298,7 +300,7 @@ func (r *KonveyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -312,13 +314,13 @@ func (r *KonveyResource) Create(ctx context.Context, req resource.CreateRequest,
 	// find parent project
 	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KonveyResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent region
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KonveyResourceName, data.Name.ValueString())
 		return
 	}
 	m := konveyResourceToModel(&ctx, data)
@@ -326,7 +328,7 @@ func (r *KonveyResource) Create(ctx context.Context, req resource.CreateRequest,
 	// create a new Konvey
 	konvey, _, err := r.Data.K.ProjectAPI.CreateProjectRegionKonvey(ctx, projectId, regionId).Konvey(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KonveyResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(konvey.Id)
@@ -342,7 +344,7 @@ func (r *KonveyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -355,7 +357,7 @@ func (r *KonveyResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	konvey, _, err := r.Data.K.KonveyAPI.ReadKonvey(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, KonveyResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -370,7 +372,7 @@ func (r *KonveyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -384,7 +386,7 @@ func (r *KonveyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	m := konveyResourceToModel(&ctx, data)
 	_, _, err := r.Data.K.KonveyAPI.UpdateKonvey(ctx, data.ID.ValueString()).Konvey(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, KonveyResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -398,7 +400,7 @@ func (r *KonveyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -411,7 +413,7 @@ func (r *KonveyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	_, err := r.Data.K.KonveyAPI.DeleteKonvey(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, KonveyResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -2,19 +2,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
+	"slices"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,6 +30,16 @@ const (
 
 	KyloDefaultValueNfs        = ""
 	KyloDefaultValueAccessType = "RW"
+	KyloDefaultValueSize       = 0
+	KyloDefaultValueStrict     = false
+
+	KyloMountCommandFormat = "mount -t nfs -o vers=%d %s:/ /mnt/%s"
+	KyloEndpointFormat     = "%s:/"
+	KyloProtocolNfs3       = 3
+	KyloProtocolNfs4       = 4
+
+	KyloHealthMessageReady   = "Endpoint available"
+	KyloHealthMessagePending = "Endpoint not yet published by backend"
 )
 
 var _ resource.Resource = &KyloResource{}
@@ -47,8 +63,16 @@ type KyloResourceModel struct {
 	Nfs       types.String   `tfsdk:"nfs"`
 	Access    types.String   `tfsdk:"access_type"`
 	Protocols types.List     `tfsdk:"protocols"`
+	Strict    types.Bool     `tfsdk:"strict_protocols"`
+	Size      types.Int64    `tfsdk:"size"`
+	Clients   types.List     `tfsdk:"allowed_clients"`
 	// read-only
-	Endpoint types.String `tfsdk:"endpoint"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	Nfs3Endpoint  types.String `tfsdk:"nfs3_endpoint"`
+	Nfs4Endpoint  types.String `tfsdk:"nfs4_endpoint"`
+	MountCommand  types.String `tfsdk:"mount_command"`
+	Ready         types.Bool   `tfsdk:"ready"`
+	HealthMessage types.String `tfsdk:"health_message"`
 }
 
 func (r *KyloResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -56,8 +80,7 @@ func (r *KyloResource) Metadata(ctx context.Context, req resource.MetadataReques
 }
 
 func (r *KyloResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
-	resource.ImportStatePassthroughID(ctx, path.Root(KeyIP), req, resp)
+	resourceImportStateComposite(ctx, req, resp, KeyProject, KeyRegion)
 }
 
 func (r *KyloResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -70,6 +93,7 @@ func (r *KyloResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 		types.Int64Value(4),
 	}
 	protocols, _ := types.ListValue(types.Int64Type, prot)
+	clients, _ := types.ListValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Kylo distributed network storage resource. **Kylo** provides an elastic NFS-compatible endpoint.",
@@ -77,10 +101,16 @@ func (r *KyloResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			KeyProject: schema.StringAttribute{
 				MarkdownDescription: "Associated project name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyNfs: schema.StringAttribute{
 				MarkdownDescription: "Associated NFS storage name or ID (zone's default if unspecified)",
@@ -101,6 +131,31 @@ func (r *KyloResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 				Default:             listdefault.StaticValue(protocols),
 			},
+			KeyStrictProtocols: schema.BoolAttribute{
+				MarkdownDescription: "Whether to only enable the requested NFS protocols versions, disabling any other one (default: **false**)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(KyloDefaultValueStrict),
+			},
+			KeySize: schema.Int64Attribute{
+				MarkdownDescription: "Kylo's maximum usable filesystem size (expressed in GB, unlimited by default, 0 for unlimited)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Default: int64default.StaticInt64(KyloDefaultValueSize),
+			},
+			KeyAllowedClients: schema.ListAttribute{
+				MarkdownDescription: "Kylo's list of client IP addresses or CIDRs allowed to mount the NFS endpoint (defaults to any project's subnet)",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(&stringNetworkAddressValidator{}),
+				},
+				Default: listdefault.StaticValue(clients),
+			},
 			KeyEndpoint: schema.StringAttribute{
 				MarkdownDescription: "NFS Endoint (read-only)",
 				Computed:            true,
@@ -108,11 +163,82 @@ func (r *KyloResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			KeyNfs3Endpoint: schema.StringAttribute{
+				MarkdownDescription: "NFSv3 mount source, empty if protocol has not been enabled by backend (read-only)",
+				Computed:            true,
+			},
+			KeyNfs4Endpoint: schema.StringAttribute{
+				MarkdownDescription: "NFSv4 mount source, empty if protocol has not been enabled by backend (read-only)",
+				Computed:            true,
+			},
+			KeyMountCommand: schema.StringAttribute{
+				MarkdownDescription: "Recommended command to mount Kylo's NFS endpoint, using the highest protocol version enabled by backend, empty if none (read-only)",
+				Computed:            true,
+			},
+			KeyReady: schema.BoolAttribute{
+				MarkdownDescription: "Whether Kylo's NFS endpoint has been published by backend. Derived from `endpoint` being set, no actual mount readiness check is performed (read-only)",
+				Computed:            true,
+			},
+			KeyHealthMessage: schema.StringAttribute{
+				MarkdownDescription: "Kylo's endpoint publication status, derived from `endpoint` being set: `Endpoint available` or `Endpoint not yet published by backend` (read-only)",
+				Computed:            true,
+			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
+// ensures enabled NFS protocols exactly match requested ones
+func kyloCheckStrictProtocols(d *KyloResourceModel, r *sdk.Kylo) error {
+	if !d.Strict.ValueBool() || r == nil {
+		return nil
+	}
+
+	requested := []int64{}
+	d.Protocols.ElementsAs(context.TODO(), &requested, false)
+	enabled := []int64{}
+	for _, p := range r.Protocols {
+		enabled = append(enabled, int64(p))
+	}
+	slices.Sort(requested)
+	slices.Sort(enabled)
+	if !slices.Equal(requested, enabled) {
+		return fmt.Errorf("%s: requested %v, enabled %v", ErrorKyloProtocols, requested, enabled)
+	}
+
+	return nil
+}
+
+// assembles the recommended NFS mount command line, from the highest protocol version actually enabled by backend
+func kyloMountCommand(d *KyloResourceModel, enabled []int32) types.String {
+	if d.Endpoint.ValueString() == "" || len(enabled) == 0 {
+		return types.StringValue("")
+	}
+
+	version := slices.Max(enabled)
+
+	return types.StringValue(fmt.Sprintf(KyloMountCommandFormat, version, d.Endpoint.ValueString(), d.Name.ValueString()))
+}
+
+// assembles NFS mount source for given protocol version, only if actually enabled by backend
+func kyloProtocolEndpoint(endpoint types.String, enabled []int32, version int32) types.String {
+	if endpoint.ValueString() == "" || !slices.Contains(enabled, version) {
+		return types.StringValue("")
+	}
+	return types.StringValue(fmt.Sprintf(KyloEndpointFormat, endpoint.ValueString()))
+}
+
+// reports Kylo as ready as soon as backend published its NFS endpoint
+func kyloHealth(d *KyloResourceModel) {
+	ready := d.Endpoint.ValueString() != ""
+	d.Ready = types.BoolValue(ready)
+	if ready {
+		d.HealthMessage = types.StringValue(KyloHealthMessageReady)
+	} else {
+		d.HealthMessage = types.StringValue(KyloHealthMessagePending)
+	}
+}
+
 // converts kylo from Terraform model to Kowabunga API model
 func kyloResourceToModel(d *KyloResourceModel) sdk.Kylo {
 	protocols64 := []int64{}
@@ -121,12 +247,18 @@ func kyloResourceToModel(d *KyloResourceModel) sdk.Kylo {
 	for _, p := range protocols64 {
 		protocols32 = append(protocols32, int32(p))
 	}
+	size := d.Size.ValueInt64() * HelperGbToBytes
+	clients := []string{}
+	d.Clients.ElementsAs(context.TODO(), &clients, false)
 
 	return sdk.Kylo{
 		Name:        d.Name.ValueString(),
 		Description: d.Desc.ValueStringPointer(),
 		Access:      d.Access.ValueStringPointer(),
 		Protocols:   protocols32,
+		Strict:      d.Strict.ValueBoolPointer(),
+		Size:        &size,
+		Clients:     clients,
 		Endpoint:    d.Endpoint.ValueStringPointer(),
 	}
 }
@@ -138,11 +270,7 @@ func kyloModelToResource(r *sdk.Kylo, d *KyloResourceModel) {
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	if r.Access != nil {
 		d.Access = types.StringPointerValue(r.Access)
 	} else {
@@ -153,11 +281,30 @@ func kyloModelToResource(r *sdk.Kylo, d *KyloResourceModel) {
 		protocols = append(protocols, types.Int64Value(int64(p)))
 	}
 	d.Protocols, _ = types.ListValue(types.Int64Type, protocols)
+	if r.Strict != nil {
+		d.Strict = types.BoolPointerValue(r.Strict)
+	} else {
+		d.Strict = types.BoolValue(KyloDefaultValueStrict)
+	}
+	var size int64 = KyloDefaultValueSize
+	if r.Size != nil {
+		size = *r.Size / HelperGbToBytes
+	}
+	d.Size = types.Int64Value(size)
+	clients := []attr.Value{}
+	for _, c := range r.Clients {
+		clients = append(clients, types.StringValue(c))
+	}
+	d.Clients, _ = types.ListValue(types.StringType, clients)
 	if r.Endpoint != nil {
 		d.Endpoint = types.StringPointerValue(r.Endpoint)
 	} else {
 		d.Endpoint = types.StringValue("")
 	}
+	d.Nfs3Endpoint = kyloProtocolEndpoint(d.Endpoint, r.Protocols, KyloProtocolNfs3)
+	d.Nfs4Endpoint = kyloProtocolEndpoint(d.Endpoint, r.Protocols, KyloProtocolNfs4)
+	d.MountCommand = kyloMountCommand(d, r.Protocols)
+	kyloHealth(d)
 }
 
 func (r *KyloResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -167,7 +314,7 @@ func (r *KyloResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -181,13 +328,13 @@ func (r *KyloResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// find parent project
 	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KyloResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent zone
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KyloResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent NFS storage (optional)
@@ -201,13 +348,25 @@ func (r *KyloResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 	kylo, _, err := api.Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, KyloResourceName, data.Name.ValueString())
 		return
 	}
+	// strict protocols are checked against requested ones, before read back
+	strictErr := kyloCheckStrictProtocols(data, kylo)
 	data.ID = types.StringPointerValue(kylo.Id)
+	kylo = resourceWaitConsistent(ctx, KyloResourceName, data.Name.ValueString(), kylo, func() (*sdk.Kylo, error) {
+		k, _, err := r.Data.K.KyloAPI.ReadKylo(ctx, *kylo.Id).Execute()
+		return k, err
+	}, func(k *sdk.Kylo) bool {
+		return k.Endpoint != nil && *k.Endpoint != ""
+	})
 	kyloModelToResource(kylo, data) // read back resulting object
 	tflog.Trace(ctx, "created Kylo resource")
+	// Kylo exists, keep track of it even if it doesn't match strict protocols
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if strictErr != nil {
+		errorCreateGeneric(resp, strictErr, KyloResourceName, data.Name.ValueString())
+	}
 }
 
 func (r *KyloResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -217,7 +376,7 @@ func (r *KyloResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -230,7 +389,7 @@ func (r *KyloResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	kylo, _, err := r.Data.K.KyloAPI.ReadKylo(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, KyloResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -245,7 +404,7 @@ func (r *KyloResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -257,12 +416,21 @@ func (r *KyloResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	defer r.Data.Mutex.Unlock()
 
 	m := kyloResourceToModel(data)
-	_, _, err := r.Data.K.KyloAPI.UpdateKylo(ctx, data.ID.ValueString()).Kylo(m).Execute()
+	kylo, _, err := r.Data.K.KyloAPI.UpdateKylo(ctx, data.ID.ValueString()).Kylo(m).Execute()
+	if err != nil {
+		errorUpdateGeneric(resp, err, KyloResourceName, data.Name.ValueString())
+		return
+	}
+	err = kyloCheckStrictProtocols(data, kylo)
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, KyloResourceName, data.Name.ValueString())
 		return
 	}
 
+	data.Nfs3Endpoint = kyloProtocolEndpoint(data.Endpoint, kylo.Protocols, KyloProtocolNfs3)
+	data.Nfs4Endpoint = kyloProtocolEndpoint(data.Endpoint, kylo.Protocols, KyloProtocolNfs4)
+	data.MountCommand = kyloMountCommand(data, kylo.Protocols)
+	kyloHealth(data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -272,7 +440,7 @@ func (r *KyloResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -285,7 +453,7 @@ func (r *KyloResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	_, err := r.Data.K.KyloAPI.DeleteKylo(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, KyloResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
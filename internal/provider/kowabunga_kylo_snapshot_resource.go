@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	KyloSnapshotResourceName = "kylo_snapshot"
+)
+
+var _ resource.Resource = &KyloSnapshotResource{}
+var _ resource.ResourceWithImportState = &KyloSnapshotResource{}
+
+func NewKyloSnapshotResource() resource.Resource {
+	return &KyloSnapshotResource{}
+}
+
+type KyloSnapshotResource struct {
+	Data *KowabungaProviderData
+}
+
+type KyloSnapshotResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Name     types.String   `tfsdk:"name"`
+	Desc     types.String   `tfsdk:"desc"`
+	Kylo     types.String   `tfsdk:"kylo"`
+	// read-only
+	Size types.Int64 `tfsdk:"size"`
+}
+
+func (r *KyloSnapshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resourceMetadata(req, resp, KyloSnapshotResourceName)
+}
+
+func (r *KyloSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// parent kylo can't be read back from snapshot and would force replacement if left unset
+	if !strings.Contains(req.ID, ImportIdSeparator) {
+		resp.Diagnostics.AddError(ErrorImportComposite, fmt.Sprintf("Expected import identifier with format: %s%s%s. Got: %q", KeyKylo, ImportIdSeparator, ImportIdPlaceholder, req.ID))
+		return
+	}
+	resourceImportStateComposite(ctx, req, resp, KeyKylo)
+}
+
+func (r *KyloSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Data = resourceConfigure(req, resp)
+}
+
+func (r *KyloSnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Kylo snapshot resource. A **Kylo snapshot** captures a point-in-time copy of a Kylo distributed network storage.",
+		Attributes: map[string]schema.Attribute{
+			KeyKylo: schema.StringAttribute{
+				MarkdownDescription: "Associated Kylo name or ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeySize: schema.Int64Attribute{
+				MarkdownDescription: "Kylo snapshot size (expressed in GB, read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
+}
+
+// converts kylo snapshot from Terraform model to Kowabunga API model
+func kyloSnapshotResourceToModel(d *KyloSnapshotResourceModel) sdk.KyloSnapshot {
+	return sdk.KyloSnapshot{
+		Name:        d.Name.ValueString(),
+		Description: d.Desc.ValueStringPointer(),
+	}
+}
+
+// converts kylo snapshot from Kowabunga API model to Terraform model
+func kyloSnapshotModelToResource(r *sdk.KyloSnapshot, d *KyloSnapshotResourceModel) {
+	if r == nil {
+		return
+	}
+
+	d.Name = types.StringValue(r.Name)
+	d.Desc = descModelToResource(r.Description)
+	var size int64 = 0
+	if r.Size != nil {
+		size = *r.Size / HelperGbToBytes
+	}
+	d.Size = types.Int64Value(size)
+}
+
+func (r *KyloSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *KyloSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	// find parent kylo
+	kyloId, err := getKyloID(ctx, r.Data, data.Kylo.ValueString())
+	if err != nil {
+		errorCreateGeneric(resp, err, KyloSnapshotResourceName, data.Name.ValueString())
+		return
+	}
+
+	// create a new Kylo snapshot
+	m := kyloSnapshotResourceToModel(data)
+	snapshot, _, err := r.Data.K.KyloAPI.CreateKyloSnapshot(ctx, kyloId).KyloSnapshot(m).Execute()
+	if err != nil {
+		errorCreateGeneric(resp, err, KyloSnapshotResourceName, data.Name.ValueString())
+		return
+	}
+	data.ID = types.StringPointerValue(snapshot.Id)
+	kyloSnapshotModelToResource(snapshot, data) // read back resulting object
+	tflog.Trace(ctx, "created Kylo snapshot resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KyloSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *KyloSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	snapshot, _, err := r.Data.K.KyloAPI.ReadKyloSnapshot(ctx, data.ID.ValueString()).Execute()
+	if err != nil {
+		errorReadGeneric(resp, err, KyloSnapshotResourceName, data.Name.ValueString())
+		return
+	}
+
+	kyloSnapshotModelToResource(snapshot, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KyloSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *KyloSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	m := kyloSnapshotResourceToModel(data)
+	_, _, err := r.Data.K.KyloAPI.UpdateKyloSnapshot(ctx, data.ID.ValueString()).KyloSnapshot(m).Execute()
+	if err != nil {
+		errorUpdateGeneric(resp, err, KyloSnapshotResourceName, data.Name.ValueString())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KyloSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *KyloSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	_, err := r.Data.K.KyloAPI.DeleteKyloSnapshot(ctx, data.ID.ValueString()).Execute()
+	if err != nil {
+		errorDeleteGeneric(resp, err, KyloSnapshotResourceName, data.Name.ValueString())
+		return
+	}
+	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
+}
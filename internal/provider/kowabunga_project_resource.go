@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"maps"
+	"net/http"
 	"sort"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -34,10 +37,12 @@ const (
 	ProjectDefaultValueMaxMemory    = 0
 	ProjectDefaultValueMaxStorage   = 0
 	ProjectDefaultValueMaxVCPUs     = 0
+	ProjectDefaultValueForceDestroy = false
 )
 
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -57,6 +62,10 @@ type ProjectResourceModel struct {
 	RootPassword   types.String   `tfsdk:"root_password"`
 	User           types.String   `tfsdk:"bootstrap_user"`
 	Pubkey         types.String   `tfsdk:"bootstrap_pubkey"`
+	Sudo           types.Bool     `tfsdk:"bootstrap_sudo"`
+	Groups         types.List     `tfsdk:"bootstrap_groups"`
+	EffectiveUser  types.String   `tfsdk:"effective_bootstrap_user"`
+	EffectiveKey   types.String   `tfsdk:"effective_bootstrap_pubkey"`
 	Tags           types.List     `tfsdk:"tags"`
 	Metadatas      types.Map      `tfsdk:"metadata"`
 	MaxInstances   types.Int64    `tfsdk:"max_instances"`
@@ -67,6 +76,8 @@ type ProjectResourceModel struct {
 	Teams          types.List     `tfsdk:"teams"`
 	Regions        types.List     `tfsdk:"regions"`
 	VRIDs          types.List     `tfsdk:"vrids"`
+	Notify         types.Bool     `tfsdk:"notify"`
+	ForceDestroy   types.Bool     `tfsdk:"force_destroy"`
 }
 
 type ProjectQuotaModel struct {
@@ -77,7 +88,7 @@ func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, "", getProjectID)
 	resource.ImportStatePassthroughID(ctx, path.Root(KeyPrivateSubnets), req, resp)
 }
 
@@ -126,6 +137,37 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			KeyBootstrapSudo: schema.BoolAttribute{
+				MarkdownDescription: "Whether the project default service user is granted passwordless sudo privileges at cloud-init instance bootstrap phase. Will use Kowabunga's default configuration one if unspecified.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyBootstrapGroups: schema.ListAttribute{
+				MarkdownDescription: "The list of supplementary groups the project default service user is made a member of at cloud-init instance bootstrap phase. Will use Kowabunga's default configuration ones if unspecified.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyEffectiveBootstrapUser: schema.StringAttribute{
+				MarkdownDescription: "The project actual service user name, i.e. bootstrap_user if specified, Kowabunga's default configuration one otherwise (read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			KeyEffectiveBootstrapPubkey: schema.StringAttribute{
+				MarkdownDescription: "The project actual public SSH key, i.e. bootstrap_pubkey if specified, Kowabunga's default configuration one otherwise (read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			KeyTags: schema.ListAttribute{
 				MarkdownDescription: "List of tags associated with the project",
 				ElementType:         types.StringType,
@@ -178,6 +220,13 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				ElementType:         types.StringType,
 				Required:            true,
 			},
+			KeyNotify: resourceAttributeNotify("project"),
+			KeyForceDestroy: schema.BoolAttribute{
+				MarkdownDescription: "Whether all project-owned resources (DNS records, Konveys, Komputes, instances, volumes, Kylos and Kawaiis) should be deleted beforehand when destroying the project (default: **false**). Must have been applied to state prior to destruction. **WARNING**: this is irreversible, use with great care.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(ProjectDefaultValueForceDestroy),
+			},
 			KeyVRIDs: schema.ListAttribute{
 				Computed:            true,
 				MarkdownDescription: "List of VRRP IDs used by -as-a-service resources within the project virtual network (read-only). Should your application use VRRP for service redundancy, you should use different IDs to prevent issues.",
@@ -191,22 +240,30 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
-// converts project from Terraform model to Kowabunga API model
-func projectResourceToModel(d *ProjectResourceModel) sdk.Project {
-	tags := []string{}
-	d.Tags.ElementsAs(context.TODO(), &tags, false)
-
-	metas := map[string]string{}
-	d.Metadatas.ElementsAs(context.TODO(), &metas, false)
-	metadatas := []sdk.Metadata{}
-	for k, v := range metas {
-		m := sdk.Metadata{
-			Key:   k,
-			Value: v,
-		}
-		metadatas = append(metadatas, m)
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *ProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// effective bootstrap settings are to be resolved again
+	if !plan.User.Equal(state.User) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(KeyEffectiveBootstrapUser), types.StringUnknown())...)
 	}
+	if !plan.Pubkey.Equal(state.Pubkey) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(KeyEffectiveBootstrapPubkey), types.StringUnknown())...)
+	}
+}
 
+// converts project from Terraform model to Kowabunga API model
+func projectResourceToModel(d *ProjectResourceModel) sdk.Project {
 	instances := int32(d.MaxInstances.ValueInt64())
 	memory := int64(d.MaxMemory.ValueInt64()) * HelperGbToBytes
 	storage := int64(d.MaxStorage.ValueInt64()) * HelperGbToBytes
@@ -226,6 +283,13 @@ func projectResourceToModel(d *ProjectResourceModel) sdk.Project {
 	d.Regions.ElementsAs(context.TODO(), &regions, false)
 	sort.Strings(regions)
 
+	// unset groups are left to Kowabunga's default configuration
+	var groups []string
+	if !d.Groups.IsNull() && !d.Groups.IsUnknown() {
+		groups = []string{}
+		d.Groups.ElementsAs(context.TODO(), &groups, false)
+	}
+
 	return sdk.Project{
 		Name:            d.Name.ValueString(),
 		Description:     d.Desc.ValueStringPointer(),
@@ -233,14 +297,27 @@ func projectResourceToModel(d *ProjectResourceModel) sdk.Project {
 		RootPassword:    d.RootPassword.ValueStringPointer(),
 		BootstrapUser:   d.User.ValueStringPointer(),
 		BootstrapPubkey: d.Pubkey.ValueStringPointer(),
-		Tags:            tags,
-		Metadatas:       metadatas,
+		BootstrapSudo:   d.Sudo.ValueBoolPointer(),
+		BootstrapGroups: groups,
+		Tags:            tagsResourceToModel(d.Tags),
+		Metadatas:       metadatasResourceToModel(d.Metadatas),
 		Quotas:          quotas,
 		Teams:           teams,
 		Regions:         regions,
 	}
 }
 
+// resolves project's bootstrap setting, falling back to Kowabunga's default configuration one
+func projectEffectiveBootstrap(value *string, fallback *string) types.String {
+	if value != nil && *value != "" {
+		return types.StringPointerValue(value)
+	}
+	if fallback != nil {
+		return types.StringPointerValue(fallback)
+	}
+	return types.StringValue("")
+}
+
 // converts project from Kowabunga API model to Terraform model
 func projectModelToResource(r *sdk.Project, d *ProjectResourceModel) {
 	if r == nil {
@@ -248,11 +325,7 @@ func projectModelToResource(r *sdk.Project, d *ProjectResourceModel) {
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	if r.Domain != nil {
 		d.Domain = types.StringPointerValue(r.Domain)
 	} else {
@@ -273,18 +346,21 @@ func projectModelToResource(r *sdk.Project, d *ProjectResourceModel) {
 	} else {
 		d.Pubkey = types.StringValue("")
 	}
-
-	tags := []attr.Value{}
-	for _, t := range r.Tags {
-		tags = append(tags, types.StringValue(t))
+	d.EffectiveUser = projectEffectiveBootstrap(r.BootstrapUser, r.DefaultBootstrapUser)
+	d.EffectiveKey = projectEffectiveBootstrap(r.BootstrapPubkey, r.DefaultBootstrapPubkey)
+	d.Sudo = types.BoolPointerValue(r.BootstrapSudo)
+	if len(r.BootstrapGroups) > 0 || (!d.Groups.IsNull() && !d.Groups.IsUnknown()) {
+		groups := []attr.Value{}
+		for _, g := range r.BootstrapGroups {
+			groups = append(groups, types.StringValue(g))
+		}
+		d.Groups, _ = types.ListValue(types.StringType, groups)
+	} else {
+		d.Groups = types.ListNull(types.StringType)
 	}
-	d.Tags, _ = types.ListValue(types.StringType, tags)
 
-	metadatas := map[string]attr.Value{}
-	for _, m := range r.Metadatas {
-		metadatas[m.Key] = types.StringValue(m.Value)
-	}
-	d.Metadatas = basetypes.NewMapValueMust(types.StringType, metadatas)
+	d.Tags = tagsModelToResource(r.Tags)
+	d.Metadatas = metadatasModelToResource(r.Metadatas)
 
 	if r.Quotas.Instances != nil {
 		d.MaxInstances = types.Int64Value(int64(*r.Quotas.Instances))
@@ -350,7 +426,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -363,9 +439,14 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// create a new project
 	m := projectResourceToModel(data)
-	project, _, err := r.Data.K.ProjectAPI.CreateProject(ctx).Project(m).SubnetSize(int32(data.SubnetSize.ValueInt64())).Execute()
+	data.Notify = resourceNotify(r.Data, data.Notify)
+	project, _, err := r.Data.K.ProjectAPI.CreateProject(ctx).Project(m).SubnetSize(int32(data.SubnetSize.ValueInt64())).Notify(data.Notify.ValueBool()).Execute()
+	if id, ok := resourceAdoptExisting(ctx, r.Data, err, ProjectResourceName, data.Name.ValueString(), getProjectID); ok {
+		// converge adopted project to planned configuration
+		project, _, err = r.Data.K.ProjectAPI.UpdateProject(ctx, id).Project(m).Execute()
+	}
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, ProjectResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(project.Id)
@@ -382,7 +463,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -395,11 +476,14 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	project, _, err := r.Data.K.ProjectAPI.ReadProject(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, ProjectResourceName, data.Name.ValueString())
 		return
 	}
 
 	projectModelToResource(project, data)
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(ProjectDefaultValueForceDestroy)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -411,7 +495,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -423,15 +507,103 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	defer r.Data.Mutex.Unlock()
 
 	m := projectResourceToModel(data)
-	_, _, err := r.Data.K.ProjectAPI.UpdateProject(ctx, data.ID.ValueString()).Project(m).Execute()
+	project, _, err := r.Data.K.ProjectAPI.UpdateProject(ctx, data.ID.ValueString()).Project(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, ProjectResourceName, data.Name.ValueString())
 		return
 	}
+	data.EffectiveUser = projectEffectiveBootstrap(project.BootstrapUser, project.DefaultBootstrapUser)
+	data.EffectiveKey = projectEffectiveBootstrap(project.BootstrapPubkey, project.DefaultBootstrapPubkey)
+	// unset bootstrap privileges are resolved by Kowabunga's default configuration
+	if data.Sudo.IsUnknown() {
+		data.Sudo = types.BoolPointerValue(project.BootstrapSudo)
+	}
+	if data.Groups.IsUnknown() {
+		data.Groups, _ = types.ListValueFrom(ctx, types.StringType, project.BootstrapGroups)
+	}
+	data.Notify = resourceNotify(r.Data, data.Notify)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// project-owned resources deletion step
+type projectDrainStep struct {
+	kind   string
+	list   func() ([]string, *http.Response, error)
+	delete func(id string) (*http.Response, error)
+}
+
+// deletes all project-owned resources, in dependency order, so that project itself can be deleted
+func projectDrain(ctx context.Context, data *KowabungaProviderData, projectId string) error {
+	steps := []projectDrainStep{
+		{
+			kind: DnsRecordResourceName,
+			list: data.K.ProjectAPI.ListProjectDnsRecords(ctx, projectId).Execute,
+			delete: func(id string) (*http.Response, error) {
+				return data.K.RecordAPI.DeleteDnsRecord(ctx, id).Execute()
+			},
+		},
+		{
+			kind: KonveyResourceName,
+			list: data.K.ProjectAPI.ListProjectKonveys(ctx, projectId).Execute,
+			delete: func(id string) (*http.Response, error) {
+				return data.K.KonveyAPI.DeleteKonvey(ctx, id).Execute()
+			},
+		},
+		{
+			kind: KomputeResourceName,
+			list: data.K.ProjectAPI.ListProjectKomputes(ctx, projectId).Execute,
+			delete: func(id string) (*http.Response, error) {
+				return data.K.KomputeAPI.DeleteKompute(ctx, id).Execute()
+			},
+		},
+		{
+			kind: InstanceResourceName,
+			list: data.K.ProjectAPI.ListProjectInstances(ctx, projectId).Execute,
+			delete: func(id string) (*http.Response, error) {
+				return data.K.InstanceAPI.DeleteInstance(ctx, id).Execute()
+			},
+		},
+		{
+			kind: VolumeResourceName,
+			list: data.K.ProjectAPI.ListProjectVolumes(ctx, projectId).Execute,
+			delete: func(id string) (*http.Response, error) {
+				return data.K.VolumeAPI.DeleteVolume(ctx, id).Execute()
+			},
+		},
+		{
+			kind: KyloResourceName,
+			list: data.K.ProjectAPI.ListProjectKylos(ctx, projectId).Execute,
+			delete: func(id string) (*http.Response, error) {
+				return data.K.KyloAPI.DeleteKylo(ctx, id).Execute()
+			},
+		},
+		{
+			kind: KawaiiResourceName,
+			list: data.K.ProjectAPI.ListProjectKawaiis(ctx, projectId).Execute,
+			delete: func(id string) (*http.Response, error) {
+				return data.K.KawaiiAPI.DeleteKawaii(ctx, id).Execute()
+			},
+		},
+	}
+
+	for _, step := range steps {
+		ids, _, err := step.list()
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			tflog.Debug(ctx, "Force-destroying project "+step.kind+" "+id)
+			_, err := step.delete(id)
+			if err != nil && !apiErrorIsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *ProjectResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -439,7 +611,7 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -450,9 +622,17 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
+	if data.ForceDestroy.ValueBool() {
+		err := projectDrain(ctx, r.Data, data.ID.ValueString())
+		if err != nil {
+			errorDeleteGeneric(resp, err, ProjectResourceName, data.Name.ValueString())
+			return
+		}
+	}
+
 	_, err := r.Data.K.ProjectAPI.DeleteProject(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, ProjectResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
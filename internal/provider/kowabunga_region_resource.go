@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"maps"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
@@ -32,6 +33,7 @@ type RegionResourceModel struct {
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 	Name     types.String   `tfsdk:"name"`
 	Desc     types.String   `tfsdk:"desc"`
+	Zone     types.String   `tfsdk:"default_zone"`
 }
 
 func (r *RegionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,7 +41,7 @@ func (r *RegionResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *RegionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, "", getRegionID)
 }
 
 func (r *RegionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -49,29 +51,51 @@ func (r *RegionResource) Configure(ctx context.Context, req resource.ConfigureRe
 func (r *RegionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a region resource",
-		Attributes:          resourceAttributes(&ctx),
+		Attributes: map[string]schema.Attribute{
+			KeyDefaultZone: schema.StringAttribute{
+				MarkdownDescription: "Region's default zone name or ID, hosting region-scoped resources (e.g. KFS, KGW). Left unmanaged if unset",
+				Optional:            true,
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
+}
+
+// resolves region's declared default zone into ID
+func getRegionDefaultZoneID(ctx context.Context, data *KowabungaProviderData, d *RegionResourceModel) (string, error) {
+	if d.Zone.IsNull() {
+		return "", nil
 	}
+	return getZoneID(ctx, data, d.Zone.ValueString())
 }
 
 // converts region from Terraform model to Kowabunga API model
-func regionResourceToModel(d *RegionResourceModel) sdk.Region {
-	return sdk.Region{
+func regionResourceToModel(d *RegionResourceModel, zoneId string) sdk.Region {
+	region := sdk.Region{
 		Name:        d.Name.ValueString(),
 		Description: d.Desc.ValueStringPointer(),
 	}
+	if zoneId != "" {
+		region.DefaultZone = &zoneId
+	}
+	return region
 }
 
 // converts region from Kowabunga API model to Terraform model
-func regionModelToResource(r *sdk.Region, d *RegionResourceModel) {
+func regionModelToResource(r *sdk.Region, d *RegionResourceModel, zoneId string) {
 	if r == nil {
 		return
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
+	d.Desc = descModelToResource(r.Description)
+	// keep declared zone spelling if it still matches
+	if !d.Zone.IsNull() && (r.DefaultZone == nil || *r.DefaultZone != zoneId) {
+		if r.DefaultZone != nil {
+			d.Zone = types.StringPointerValue(r.DefaultZone)
+		} else {
+			d.Zone = types.StringValue("")
+		}
 	}
 }
 
@@ -82,7 +106,7 @@ func (r *RegionResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -93,14 +117,24 @@ func (r *RegionResource) Create(ctx context.Context, req resource.CreateRequest,
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := regionResourceToModel(data)
+	zoneId, err := getRegionDefaultZoneID(ctx, r.Data, data)
+	if err != nil {
+		errorCreateGeneric(resp, err, RegionResourceName, data.Name.ValueString())
+		return
+	}
+
+	m := regionResourceToModel(data, zoneId)
 	region, _, err := r.Data.K.RegionAPI.CreateRegion(ctx).Region(m).Execute()
+	if id, ok := resourceAdoptExisting(ctx, r.Data, err, RegionResourceName, data.Name.ValueString(), getRegionID); ok {
+		// converge adopted region to planned configuration
+		region, _, err = r.Data.K.RegionAPI.UpdateRegion(ctx, id).Region(m).Execute()
+	}
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, RegionResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(region.Id)
-	regionModelToResource(region, data) // read back resulting object
+	regionModelToResource(region, data, zoneId) // read back resulting object
 	tflog.Trace(ctx, "created region resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -111,7 +145,7 @@ func (r *RegionResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -124,11 +158,13 @@ func (r *RegionResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	region, _, err := r.Data.K.RegionAPI.ReadRegion(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, RegionResourceName, data.Name.ValueString())
 		return
 	}
 
-	regionModelToResource(region, data)
+	// unresolvable zone is reported as drift
+	zoneId, _ := getRegionDefaultZoneID(ctx, r.Data, data)
+	regionModelToResource(region, data, zoneId)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -139,7 +175,7 @@ func (r *RegionResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -149,10 +185,16 @@ func (r *RegionResource) Update(ctx context.Context, req resource.UpdateRequest,
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := regionResourceToModel(data)
-	_, _, err := r.Data.K.RegionAPI.UpdateRegion(ctx, data.ID.ValueString()).Region(m).Execute()
+	zoneId, err := getRegionDefaultZoneID(ctx, r.Data, data)
+	if err != nil {
+		errorUpdateGeneric(resp, err, RegionResourceName, data.Name.ValueString())
+		return
+	}
+
+	m := regionResourceToModel(data, zoneId)
+	_, _, err = r.Data.K.RegionAPI.UpdateRegion(ctx, data.ID.ValueString()).Region(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, RegionResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -166,7 +208,7 @@ func (r *RegionResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -179,7 +221,7 @@ func (r *RegionResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	_, err := r.Data.K.RegionAPI.DeleteRegion(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, RegionResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
 	"sort"
 
@@ -10,13 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,11 +30,11 @@ const (
 	StorageNfsResourceName                      = "storage_nfs"
 	StorageNfsDefaultValueFs                    = "nfs"
 	StorageNfsDefaultValueGaneshaApiPortDefault = 54934
-	StorageNfsDefaultValueDefault               = false
 )
 
 var _ resource.Resource = &StorageNfsResource{}
 var _ resource.ResourceWithImportState = &StorageNfsResource{}
+var _ resource.ResourceWithModifyPlan = &StorageNfsResource{}
 
 func NewStorageNfsResource() resource.Resource {
 	return &StorageNfsResource{}
@@ -60,7 +63,7 @@ func (r *StorageNfsResource) Metadata(ctx context.Context, req resource.Metadata
 }
 
 func (r *StorageNfsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, KeyRegion, getNfsID)
 }
 
 func (r *StorageNfsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -74,6 +77,9 @@ func (r *StorageNfsResource) Schema(ctx context.Context, req resource.SchemaRequ
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyPool: schema.StringAttribute{
 				MarkdownDescription: "Associated storage pool name or ID (region's default if unspecified)",
@@ -108,10 +114,12 @@ func (r *StorageNfsResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Default: int64default.StaticInt64(StorageNfsDefaultValueGaneshaApiPortDefault),
 			},
 			KeyDefault: schema.BoolAttribute{
-				MarkdownDescription: "Whether to set NFS storage as region's default one (default: **false**). First NFS storage to be created is always considered as default's one.",
+				MarkdownDescription: "Whether to set NFS storage as region's default one. First NFS storage to be created is always considered as default's one. A default NFS storage can't be unset, set another one as default instead. Reflects backend's value if unspecified.",
 				Computed:            true,
 				Optional:            true,
-				Default:             booldefault.StaticBool(StorageNfsDefaultValueDefault),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -141,11 +149,7 @@ func storageNfsModelToResource(r *sdk.StorageNFS, d *StorageNfsResourceModel) {
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	d.Endpoint = types.StringValue(r.Endpoint)
 	if r.Fs != nil {
 		d.FS = types.StringPointerValue(r.Fs)
@@ -163,6 +167,53 @@ func storageNfsModelToResource(r *sdk.StorageNFS, d *StorageNfsResourceModel) {
 	} else {
 		d.Port = types.Int64Value(StorageNfsDefaultValueGaneshaApiPortDefault)
 	}
+	d.Default = types.BoolValue(r.Default != nil && *r.Default)
+}
+
+func (r *StorageNfsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is being destroyed, or provider is not yet configured
+	if req.Plan.Raw.IsNull() || r.Data == nil {
+		return
+	}
+
+	var config, plan *StorageNfsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only an explicit opt-out can conflict with backend's forced default
+	if config.Default.IsNull() || config.Default.IsUnknown() || config.Default.ValueBool() {
+		return
+	}
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	forced := false
+	if req.State.Raw.IsNull() {
+		// first NFS storage to be created is always the default one
+		storages, _, err := r.Data.K.NfsAPI.ListStorageNFSs(ctx).Execute()
+		if err != nil {
+			tflog.Warn(ctx, "unable to list NFS storages: "+err.Error())
+			return
+		}
+		forced = len(storages) == 0
+	} else {
+		var state *StorageNfsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// there's no way to unset a region's default, only to set another one
+		forced = state.Default.ValueBool()
+	}
+
+	if forced {
+		resp.Diagnostics.AddAttributeError(path.Root(KeyDefault), ErrorNfsDefaultForced,
+			fmt.Sprintf("%s: NFS storage %s is (or will be) region's default one, remove the attribute or set another NFS storage as default instead", ErrorNfsDefaultForced, plan.Name.ValueString()))
+	}
 }
 
 func (r *StorageNfsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -171,7 +222,7 @@ func (r *StorageNfsResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -185,7 +236,7 @@ func (r *StorageNfsResource) Create(ctx context.Context, req resource.CreateRequ
 	// find parent region
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent pool (optional)
@@ -200,14 +251,19 @@ func (r *StorageNfsResource) Create(ctx context.Context, req resource.CreateRequ
 
 	nfs, _, err := api.Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
 		return
 	}
 	// set NFS storage as default
 	if data.Default.ValueBool() {
 		_, err = r.Data.K.RegionAPI.SetRegionDefaultStorageNFS(ctx, regionId, *nfs.Id).Execute()
 		if err != nil {
-			errorCreateGeneric(resp, err)
+			errorCreateGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
+			return
+		}
+		nfs, _, err = r.Data.K.NfsAPI.ReadStorageNFS(ctx, *nfs.Id).Execute()
+		if err != nil {
+			errorCreateGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
 			return
 		}
 	}
@@ -225,7 +281,7 @@ func (r *StorageNfsResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -238,7 +294,7 @@ func (r *StorageNfsResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	nfs, _, err := r.Data.K.NfsAPI.ReadStorageNFS(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -253,7 +309,7 @@ func (r *StorageNfsResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -267,10 +323,24 @@ func (r *StorageNfsResource) Update(ctx context.Context, req resource.UpdateRequ
 	m := storageNfsResourceToModel(data)
 	_, _, err := r.Data.K.NfsAPI.UpdateStorageNFS(ctx, data.ID.ValueString()).StorageNFS(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
 		return
 	}
 
+	// set NFS storage as default
+	if data.Default.ValueBool() {
+		regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
+		if err != nil {
+			errorUpdateGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
+			return
+		}
+		_, err = r.Data.K.RegionAPI.SetRegionDefaultStorageNFS(ctx, regionId, data.ID.ValueString()).Execute()
+		if err != nil {
+			errorUpdateGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -281,7 +351,7 @@ func (r *StorageNfsResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -294,7 +364,7 @@ func (r *StorageNfsResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	_, err := r.Data.K.NfsAPI.DeleteStorageNFS(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, StorageNfsResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -8,7 +8,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -16,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -60,7 +60,7 @@ func (r *StoragePoolResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *StoragePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, KeyRegion, getPoolID)
 }
 
 func (r *StoragePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -74,6 +74,9 @@ func (r *StoragePoolResource) Schema(ctx context.Context, req resource.SchemaReq
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyPool: schema.StringAttribute{
 				MarkdownDescription: "Ceph RBD pool name",
@@ -119,7 +122,7 @@ func (r *StoragePoolResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             booldefault.StaticBool(false),
 			},
 			KeyAgents: schema.ListAttribute{
-				MarkdownDescription: "The list of Kowabunga remote agents to be associated with the storage pool",
+				MarkdownDescription: "The list of Kowabunga remote agents (name or ID) to be associated with the storage pool",
 				ElementType:         types.StringType,
 				Required:            true,
 			},
@@ -129,15 +132,12 @@ func (r *StoragePoolResource) Schema(ctx context.Context, req resource.SchemaReq
 }
 
 // converts storage pool from Terraform model to Kowabunga API model
-func storagePoolResourceToModel(d *StoragePoolResourceModel) sdk.StoragePool {
+func storagePoolResourceToModel(ctx *context.Context, d *StoragePoolResourceModel, agents map[string]string) sdk.StoragePool {
 	cost := sdk.Cost{
 		Price:    float32(d.Price.ValueFloat64()),
 		Currency: d.Currency.ValueString(),
 	}
 
-	agents := []string{}
-	d.Agents.ElementsAs(context.TODO(), &agents, false)
-
 	return sdk.StoragePool{
 		Name:           d.Name.ValueString(),
 		Description:    d.Desc.ValueStringPointer(),
@@ -146,22 +146,18 @@ func storagePoolResourceToModel(d *StoragePoolResourceModel) sdk.StoragePool {
 		CephPort:       d.Port.ValueInt64Pointer(),
 		CephSecretUuid: d.Secret.ValueStringPointer(),
 		Cost:           cost,
-		Agents:         agents,
+		Agents:         agentsResourceToModel(ctx, d.Agents, agents),
 	}
 }
 
 // converts storage pool from Kowabunga API model to Terraform model
-func storagePoolModelToResource(r *sdk.StoragePool, d *StoragePoolResourceModel) {
+func storagePoolModelToResource(r *sdk.StoragePool, d *StoragePoolResourceModel, agents map[string]string) {
 	if r == nil {
 		return
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	d.Pool = types.StringValue(r.Pool)
 	if r.CephAddress != nil {
 		d.Address = types.StringPointerValue(r.CephAddress)
@@ -180,11 +176,7 @@ func storagePoolModelToResource(r *sdk.StoragePool, d *StoragePoolResourceModel)
 	}
 	d.Price = types.Float64Value(float64(r.Cost.Price))
 	d.Currency = types.StringValue(r.Cost.Currency)
-	agents := []attr.Value{}
-	for _, a := range r.Agents {
-		agents = append(agents, types.StringValue(a))
-	}
-	d.Agents, _ = types.ListValue(types.StringType, agents)
+	d.Agents = agentsModelToResource(r.Agents, agents)
 }
 
 func (r *StoragePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -193,8 +185,9 @@ func (r *StoragePoolResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.Secret)
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -208,28 +201,34 @@ func (r *StoragePoolResource) Create(ctx context.Context, req resource.CreateReq
 	// find parent region
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, StoragePoolResourceName, data.Name.ValueString())
+		return
+	}
+	// find associated agents
+	agents, err := getAgentIDs(ctx, r.Data, data.Agents)
+	if err != nil {
+		errorCreateGeneric(resp, err, StoragePoolResourceName, data.Name.ValueString())
 		return
 	}
 
 	// create a new storage pool
-	m := storagePoolResourceToModel(data)
+	m := storagePoolResourceToModel(&ctx, data, agents)
 	pool, _, err := r.Data.K.RegionAPI.CreateStoragePool(ctx, regionId).StoragePool(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, StoragePoolResourceName, data.Name.ValueString())
 		return
 	}
 	// set storage pool as default
 	if data.Default.ValueBool() {
 		_, err = r.Data.K.RegionAPI.SetRegionDefaultStoragePool(ctx, regionId, *pool.Id).Execute()
 		if err != nil {
-			errorCreateGeneric(resp, err)
+			errorCreateGeneric(resp, err, StoragePoolResourceName, data.Name.ValueString())
 			return
 		}
 	}
 
 	data.ID = types.StringPointerValue(pool.Id)
-	storagePoolModelToResource(pool, data) // read back resulting object
+	storagePoolModelToResource(pool, data, agents) // read back resulting object
 	tflog.Trace(ctx, "created storage pool resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -240,8 +239,9 @@ func (r *StoragePoolResource) Read(ctx context.Context, req resource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.Secret)
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -254,11 +254,13 @@ func (r *StoragePoolResource) Read(ctx context.Context, req resource.ReadRequest
 
 	pool, _, err := r.Data.K.PoolAPI.ReadStoragePool(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, StoragePoolResourceName, data.Name.ValueString())
 		return
 	}
 
-	storagePoolModelToResource(pool, data)
+	// unresolvable agents are reported as drift
+	agents, _ := getAgentIDs(ctx, r.Data, data.Agents)
+	storagePoolModelToResource(pool, data, agents)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -268,8 +270,9 @@ func (r *StoragePoolResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.Secret)
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -280,10 +283,16 @@ func (r *StoragePoolResource) Update(ctx context.Context, req resource.UpdateReq
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := storagePoolResourceToModel(data)
-	_, _, err := r.Data.K.PoolAPI.UpdateStoragePool(ctx, data.ID.ValueString()).StoragePool(m).Execute()
+	agents, err := getAgentIDs(ctx, r.Data, data.Agents)
+	if err != nil {
+		errorUpdateGeneric(resp, err, StoragePoolResourceName, data.Name.ValueString())
+		return
+	}
+
+	m := storagePoolResourceToModel(&ctx, data, agents)
+	_, _, err = r.Data.K.PoolAPI.UpdateStoragePool(ctx, data.ID.ValueString()).StoragePool(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, StoragePoolResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -296,8 +305,9 @@ func (r *StoragePoolResource) Delete(ctx context.Context, req resource.DeleteReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.Secret)
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -310,7 +320,7 @@ func (r *StoragePoolResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	_, err := r.Data.K.PoolAPI.DeleteStoragePool(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, StoragePoolResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
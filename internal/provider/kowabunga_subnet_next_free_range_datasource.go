@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	SubnetNextFreeRangeDataSourceName = "subnet_next_free_range"
+
+	SubnetNextFreeRangeDataSourceErrNoFreeRange = "no free contiguous IP range left in subnet"
+)
+
+var _ datasource.DataSource = &SubnetNextFreeRangeDataSource{}
+var _ datasource.DataSourceWithConfigure = &SubnetNextFreeRangeDataSource{}
+
+func NewSubnetNextFreeRangeDataSource() datasource.DataSource {
+	return &SubnetNextFreeRangeDataSource{}
+}
+
+type SubnetNextFreeRangeDataSource struct {
+	Data *KowabungaProviderData
+}
+
+type SubnetNextFreeRangeDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Subnet types.String `tfsdk:"subnet"`
+	Size   types.Int64  `tfsdk:"size"`
+	First  types.String `tfsdk:"first"`
+	Last   types.String `tfsdk:"last"`
+	Range  types.String `tfsdk:"range"`
+}
+
+func (d *SubnetNextFreeRangeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	datasourceMetadata(req, resp, SubnetNextFreeRangeDataSourceName)
+}
+
+func (d *SubnetNextFreeRangeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Data = datasourceConfigure(req, resp)
+}
+
+func (d *SubnetNextFreeRangeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data from a subnet's first unused contiguous range of IP addresses of a given size, skipping gateway, reserved ranges, gateway pool and addresses already assigned to adapters. Suitable for subnet's reserved ranges.",
+		Attributes: map[string]schema.Attribute{
+			KeyID: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DataSourceIdDescription,
+			},
+			KeySubnet: schema.StringAttribute{
+				MarkdownDescription: "Associated subnet name or ID",
+				Required:            true,
+			},
+			KeySize: schema.Int64Attribute{
+				MarkdownDescription: "Requested number of contiguous IP addresses",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			KeyFirst: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "First IP address of the range (read-only)",
+			},
+			KeyLast: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last IP address of the range (read-only)",
+			},
+			KeyRange: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "IP range, in subnet's reserved ranges format (e.g. 192.168.0.200-192.168.0.240) (read-only)",
+			},
+		},
+	}
+}
+
+// finds subnet's first contiguous range of IP addresses neither used nor reserved
+func subnetNextFreeRange(subnet *sdk.Subnet, used map[string]bool, size int64) (netip.Addr, netip.Addr, error) {
+	prefix, err := netip.ParsePrefix(subnet.Cidr)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, err
+	}
+	prefix = prefix.Masked()
+
+	var first netip.Addr
+	var count int64
+	// skip network address
+	for ip := prefix.Addr().Next(); prefix.Contains(ip); ip = ip.Next() {
+		// skip IPv4 broadcast address
+		if ip.Is4() && !prefix.Contains(ip.Next()) {
+			break
+		}
+		if used[ip.String()] || ipAllocationInRanges(ip, subnet.Reserved) || ipAllocationInRanges(ip, subnet.GwPool) {
+			count = 0
+			continue
+		}
+		if count == 0 {
+			first = ip
+		}
+		count++
+		if count == size {
+			return first, ip, nil
+		}
+	}
+
+	return netip.Addr{}, netip.Addr{}, fmt.Errorf("%s: %s (%d addresses)", SubnetNextFreeRangeDataSourceErrNoFreeRange, subnet.Cidr, size)
+}
+
+func (d *SubnetNextFreeRangeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubnetNextFreeRangeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.Data.Mutex.Lock()
+	defer d.Data.Mutex.Unlock()
+
+	subnetId, err := getSubnetID(ctx, d.Data, data.Subnet.ValueString())
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	subnet, _, err := d.Data.K.SubnetAPI.ReadSubnet(ctx, subnetId).Execute()
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	used, err := ipAllocationUsedAddresses(ctx, d.Data, subnet)
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	first, last, err := subnetNextFreeRange(subnet, used, data.Size.ValueInt64())
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	data.ID = types.StringPointerValue(subnet.Id)
+	data.First = types.StringValue(first.String())
+	data.Last = types.StringValue(last.String())
+	data.Range = types.StringValue(fmt.Sprintf("%s-%s", first, last))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
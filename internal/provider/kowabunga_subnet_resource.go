@@ -13,7 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -57,7 +59,7 @@ func (r *SubnetResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *SubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, KeyVNet, getSubnetID)
 }
 
 func (r *SubnetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -71,6 +73,9 @@ func (r *SubnetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			KeyVNet: schema.StringAttribute{
 				MarkdownDescription: "Associated virtual network name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyCIDR: schema.StringAttribute{
 				MarkdownDescription: "Subnet CIDR",
@@ -171,11 +176,7 @@ func subnetModelToResource(s *sdk.Subnet, d *SubnetResourceModel) {
 	}
 
 	d.Name = types.StringValue(s.Name)
-	if s.Description != nil {
-		d.Desc = types.StringPointerValue(s.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(s.Description)
 	d.CIDR = types.StringValue(s.Cidr)
 	d.Gateway = types.StringValue(s.Gateway)
 	if s.Dns != nil {
@@ -218,7 +219,7 @@ func (r *SubnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -232,27 +233,36 @@ func (r *SubnetResource) Create(ctx context.Context, req resource.CreateRequest,
 	// find parent vnet
 	vnetId, err := getVNetID(ctx, r.Data, data.VNet.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, SubnetResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new subnet
 	m := subnetResourceToModel(data)
 	subnet, _, err := r.Data.K.VnetAPI.CreateSubnet(ctx, vnetId).Subnet(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, SubnetResourceName, data.Name.ValueString())
 		return
 	}
 	// set virtual network as default
 	if data.Default.ValueBool() {
-		_, err = r.Data.K.VnetAPI.SetVNetDefaultSubnet(ctx, vnetId, *subnet.Id).Execute()
+		err = setDefaultWithRetry(ctx, r.Data.K.VnetAPI.SetVNetDefaultSubnet(ctx, vnetId, *subnet.Id).Execute, func() (bool, error) {
+			vnet, _, err := r.Data.K.VnetAPI.ReadVNet(ctx, vnetId).Execute()
+			return err == nil && vnet.DefaultSubnet != nil && *vnet.DefaultSubnet == *subnet.Id, err
+		})
 		if err != nil {
-			errorCreateGeneric(resp, err)
+			errorCreateGeneric(resp, err, SubnetResourceName, data.Name.ValueString())
 			return
 		}
 	}
 
 	data.ID = types.StringPointerValue(subnet.Id)
-	//subnetModelToResource(vnet, data) // read back resulting object
+	// read back resulting object, reflecting any server-side normalization
+	subnet, _, err = r.Data.K.SubnetAPI.ReadSubnet(ctx, *subnet.Id).Execute()
+	if err != nil {
+		errorCreateGeneric(resp, err, SubnetResourceName, data.Name.ValueString())
+		return
+	}
+	subnetModelToResource(subnet, data)
 	tflog.Trace(ctx, "created subnet resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -264,7 +274,7 @@ func (r *SubnetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -277,7 +287,7 @@ func (r *SubnetResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	subnet, _, err := r.Data.K.SubnetAPI.ReadSubnet(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, SubnetResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -292,7 +302,7 @@ func (r *SubnetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -306,9 +316,16 @@ func (r *SubnetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	m := subnetResourceToModel(data)
 	_, _, err := r.Data.K.SubnetAPI.UpdateSubnet(ctx, data.ID.ValueString()).Subnet(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, SubnetResourceName, data.Name.ValueString())
 		return
 	}
+	// read back resulting object, reflecting any server-side normalization
+	subnet, _, err := r.Data.K.SubnetAPI.ReadSubnet(ctx, data.ID.ValueString()).Execute()
+	if err != nil {
+		errorUpdateGeneric(resp, err, SubnetResourceName, data.Name.ValueString())
+		return
+	}
+	subnetModelToResource(subnet, data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -320,7 +337,7 @@ func (r *SubnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -333,7 +350,7 @@ func (r *SubnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	_, err := r.Data.K.SubnetAPI.DeleteSubnet(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, SubnetResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
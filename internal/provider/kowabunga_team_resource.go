@@ -2,15 +2,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
 	"sort"
+	"strings"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -36,6 +41,7 @@ type TeamResourceModel struct {
 	Name     types.String   `tfsdk:"name"`
 	Desc     types.String   `tfsdk:"desc"`
 	Users    types.List     `tfsdk:"users"`
+	Roles    types.Map      `tfsdk:"project_roles"`
 }
 
 func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,49 +57,138 @@ func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	roles, _ := types.MapValue(types.StringType, map[string]attr.Value{})
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Kowabunga team resource",
 		Attributes: map[string]schema.Attribute{
 			KeyUsers: schema.ListAttribute{
-				MarkdownDescription: "The list of users to be associated with the instance",
+				MarkdownDescription: "The list of users (email or ID) to be associated with the instance",
 				ElementType:         types.StringType,
 				Required:            true,
 			},
+			KeyProjectRoles: schema.MapAttribute{
+				MarkdownDescription: "The map of project name or ID to role (" + strings.Join(projectSupportedRoles, ", ") + ") granted to the team's users",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(&stringProjectRoleValidator{}),
+				},
+				Default: mapdefault.StaticValue(roles),
+			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
+// resolves project roles bindings projects names or IDs into project IDs
+func getTeamProjectIDs(ctx context.Context, data *KowabungaProviderData, d *TeamResourceModel) (map[string]string, error) {
+	projects := map[string]string{}
+
+	roles := map[string]string{}
+	d.Roles.ElementsAs(ctx, &roles, false)
+	for project := range roles {
+		projectId, err := getProjectID(ctx, data, project)
+		if err != nil {
+			return projects, fmt.Errorf("%s: %s", err.Error(), project)
+		}
+		projects[project] = projectId
+	}
+
+	return projects, nil
+}
+
+// resolves team members emails or IDs into user IDs
+func getTeamUserIDs(ctx context.Context, data *KowabungaProviderData, d *TeamResourceModel) (map[string]string, error) {
+	users := map[string]string{}
+
+	members := []string{}
+	d.Users.ElementsAs(ctx, &members, false)
+	for _, member := range members {
+		userId, err := getUserID(ctx, data, member)
+		if err != nil {
+			return users, fmt.Errorf("%s: %s", err.Error(), member)
+		}
+		users[member] = userId
+	}
+
+	return users, nil
+}
+
 // converts team from Terraform model to Kowabunga API model
-func teamResourceToModel(d *TeamResourceModel) sdk.Team {
+func teamResourceToModel(d *TeamResourceModel, members map[string]string, projects map[string]string) sdk.Team {
+	declared := []string{}
+	d.Users.ElementsAs(context.TODO(), &declared, false)
 	users := []string{}
-	d.Users.ElementsAs(context.TODO(), &users, false)
+	for _, u := range declared {
+		users = append(users, members[u])
+	}
 	sort.Strings(users)
+
+	roles := map[string]string{}
+	d.Roles.ElementsAs(context.TODO(), &roles, false)
+	projectRoles := []sdk.TeamProjectRole{}
+	for project, role := range roles {
+		projectRoles = append(projectRoles, sdk.TeamProjectRole{
+			Project: projects[project],
+			Role:    role,
+		})
+	}
+	sort.Slice(projectRoles, func(i, j int) bool {
+		return projectRoles[i].Project < projectRoles[j].Project
+	})
+
 	return sdk.Team{
-		Name:        d.Name.ValueString(),
-		Description: d.Desc.ValueStringPointer(),
-		Users:       users,
+		Name:         d.Name.ValueString(),
+		Description:  d.Desc.ValueStringPointer(),
+		Users:        users,
+		ProjectRoles: projectRoles,
 	}
 }
 
 // converts team from Kowabunga API model to Terraform model
-func teamModelToResource(r *sdk.Team, d *TeamResourceModel) {
+func teamModelToResource(r *sdk.Team, d *TeamResourceModel, members map[string]string, projects map[string]string) {
 	if r == nil {
 		return
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
+	d.Desc = descModelToResource(r.Description)
+	// preserves declared users emails, as long as they resolve to team members
+	emails := map[string]string{}
+	for email, id := range members {
+		emails[id] = email
 	}
-	users := []attr.Value{}
-	sort.Strings(r.Users)
+	names := []string{}
 	for _, u := range r.Users {
+		email, ok := emails[u]
+		if ok {
+			u = email
+		}
+		names = append(names, u)
+	}
+	sort.Strings(names)
+	users := []attr.Value{}
+	for _, u := range names {
 		users = append(users, types.StringValue(u))
 	}
 	d.Users, _ = types.ListValue(types.StringType, users)
+
+	// preserves declared project names, as long as they resolve to bound project
+	declared := map[string]string{}
+	for name, id := range projects {
+		declared[id] = name
+	}
+	roles := map[string]attr.Value{}
+	for _, pr := range r.ProjectRoles {
+		project := pr.Project
+		name, ok := declared[pr.Project]
+		if ok {
+			project = name
+		}
+		roles[project] = types.StringValue(pr.Role)
+	}
+	d.Roles, _ = types.MapValue(types.StringType, roles)
 }
 
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -103,7 +198,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -114,14 +209,26 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := teamResourceToModel(data)
+	// find team members
+	members, err := getTeamUserIDs(ctx, r.Data, data)
+	if err != nil {
+		errorCreateGeneric(resp, err, TeamResourceName, data.Name.ValueString())
+		return
+	}
+	// find bound projects
+	projects, err := getTeamProjectIDs(ctx, r.Data, data)
+	if err != nil {
+		errorCreateGeneric(resp, err, TeamResourceName, data.Name.ValueString())
+		return
+	}
+	m := teamResourceToModel(data, members, projects)
 	team, _, err := r.Data.K.TeamAPI.CreateTeam(ctx).Team(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, TeamResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(team.Id)
-	teamModelToResource(team, data) // read back resulting object
+	teamModelToResource(team, data, members, projects) // read back resulting object
 
 	tflog.Trace(ctx, "created team resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -133,7 +240,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -146,11 +253,13 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	team, _, err := r.Data.K.TeamAPI.ReadTeam(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, TeamResourceName, data.Name.ValueString())
 		return
 	}
 
-	teamModelToResource(team, data)
+	members, _ := getTeamUserIDs(ctx, r.Data, data)
+	projects, _ := getTeamProjectIDs(ctx, r.Data, data)
+	teamModelToResource(team, data, members, projects)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -161,7 +270,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -171,10 +280,20 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := teamResourceToModel(data)
-	_, _, err := r.Data.K.TeamAPI.UpdateTeam(ctx, data.ID.ValueString()).Team(m).Execute()
+	members, err := getTeamUserIDs(ctx, r.Data, data)
+	if err != nil {
+		errorUpdateGeneric(resp, err, TeamResourceName, data.Name.ValueString())
+		return
+	}
+	projects, err := getTeamProjectIDs(ctx, r.Data, data)
+	if err != nil {
+		errorUpdateGeneric(resp, err, TeamResourceName, data.Name.ValueString())
+		return
+	}
+	m := teamResourceToModel(data, members, projects)
+	_, _, err = r.Data.K.TeamAPI.UpdateTeam(ctx, data.ID.ValueString()).Team(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, TeamResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -188,7 +307,7 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -201,7 +320,7 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	_, err := r.Data.K.TeamAPI.DeleteTeam(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, TeamResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"maps"
+
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	TemplateFromInstanceResourceName = "template_from_instance"
+)
+
+var _ resource.Resource = &TemplateFromInstanceResource{}
+var _ resource.ResourceWithImportState = &TemplateFromInstanceResource{}
+
+func NewTemplateFromInstanceResource() resource.Resource {
+	return &TemplateFromInstanceResource{}
+}
+
+type TemplateFromInstanceResource struct {
+	Data *KowabungaProviderData
+}
+
+type TemplateFromInstanceResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Name     types.String   `tfsdk:"name"`
+	Desc     types.String   `tfsdk:"desc"`
+	Instance types.String   `tfsdk:"instance"`
+	Pool     types.String   `tfsdk:"pool"`
+	OS       types.String   `tfsdk:"os"`
+	// read-only
+	Source types.String `tfsdk:"source"`
+}
+
+func (r *TemplateFromInstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resourceMetadata(req, resp, TemplateFromInstanceResourceName)
+}
+
+func (r *TemplateFromInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resourceImportStateComposite(ctx, req, resp, KeyPool, KeyInstance)
+}
+
+func (r *TemplateFromInstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Data = resourceConfigure(req, resp)
+}
+
+func (r *TemplateFromInstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a storage pool's template resource, captured from an existing instance's OS disk. Useful to build golden images out of a configured instance. Instance is expected to be stopped (or at least idle) for the capture to be consistent.",
+		Attributes: map[string]schema.Attribute{
+			KeyInstance: schema.StringAttribute{
+				MarkdownDescription: "Instance name or ID whose OS disk is to be captured",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyPool: schema.StringAttribute{
+				MarkdownDescription: "Target storage pool name or ID the template is to be created into",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyOS: schema.StringAttribute{
+				MarkdownDescription: "The template type (valid options: 'linux', 'windows'). Defaults to **linux**.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(TemplateDefaultValueOS),
+			},
+			KeySource: schema.StringAttribute{
+				MarkdownDescription: "The template source, as assigned by Kowabunga upon capture (read-only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
+}
+
+// converts captured template from Terraform model to Kowabunga API model
+func templateFromInstanceResourceToModel(d *TemplateFromInstanceResourceModel) sdk.Template {
+	return sdk.Template{
+		Name:        d.Name.ValueString(),
+		Description: d.Desc.ValueStringPointer(),
+		Os:          d.OS.ValueStringPointer(),
+		Source:      d.Source.ValueString(),
+	}
+}
+
+// converts captured template from Kowabunga API model to Terraform model
+func templateFromInstanceModelToResource(r *sdk.Template, d *TemplateFromInstanceResourceModel) {
+	if r == nil {
+		return
+	}
+
+	d.Name = types.StringValue(r.Name)
+	d.Desc = descModelToResource(r.Description)
+	if r.Os != nil {
+		d.OS = types.StringPointerValue(r.Os)
+	} else {
+		d.OS = types.StringValue(TemplateDefaultValueOS)
+	}
+	d.Source = types.StringValue(r.Source)
+}
+
+func (r *TemplateFromInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TemplateFromInstanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	// find source instance
+	instanceId, err := getInstanceID(ctx, r.Data, data.Instance.ValueString())
+	if err != nil {
+		errorCreateGeneric(resp, err, TemplateFromInstanceResourceName, data.Name.ValueString())
+		return
+	}
+	// find target pool
+	poolId, err := getPoolID(ctx, r.Data, data.Pool.ValueString())
+	if err != nil {
+		errorCreateGeneric(resp, err, TemplateFromInstanceResourceName, data.Name.ValueString())
+		return
+	}
+
+	// capture instance's OS disk into a new template
+	m := templateFromInstanceResourceToModel(data)
+	stop := resourceProgressStart(ctx, "capturing", TemplateFromInstanceResourceName, data.Name.ValueString())
+	template, _, err := r.Data.K.InstanceAPI.CreateInstanceTemplate(ctx, instanceId).PoolId(poolId).Template(m).Execute()
+	stop()
+	if err != nil {
+		errorCreateGeneric(resp, err, TemplateFromInstanceResourceName, data.Name.ValueString())
+		return
+	}
+
+	data.ID = types.StringPointerValue(template.Id)
+	templateFromInstanceModelToResource(template, data) // read back resulting object
+	tflog.Trace(ctx, "created template from instance resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateFromInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TemplateFromInstanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	template, _, err := r.Data.K.TemplateAPI.ReadTemplate(ctx, data.ID.ValueString()).Execute()
+	if err != nil {
+		errorReadGeneric(resp, err, TemplateFromInstanceResourceName, data.Name.ValueString())
+		return
+	}
+
+	templateFromInstanceModelToResource(template, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateFromInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TemplateFromInstanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	m := templateFromInstanceResourceToModel(data)
+	_, _, err := r.Data.K.TemplateAPI.UpdateTemplate(ctx, data.ID.ValueString()).Template(m).Execute()
+	if err != nil {
+		errorUpdateGeneric(resp, err, TemplateFromInstanceResourceName, data.Name.ValueString())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateFromInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TemplateFromInstanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	_, err := r.Data.K.TemplateAPI.DeleteTemplate(ctx, data.ID.ValueString()).Execute()
+	if err != nil {
+		errorDeleteGeneric(resp, err, TemplateFromInstanceResourceName, data.Name.ValueString())
+		return
+	}
+	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
+}
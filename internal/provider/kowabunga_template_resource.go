@@ -10,7 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -49,7 +51,7 @@ func (r *TemplateResource) Metadata(ctx context.Context, req resource.MetadataRe
 }
 
 func (r *TemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, KeyPool, getTemplateID)
 }
 
 func (r *TemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -63,6 +65,9 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 			KeyPool: schema.StringAttribute{
 				MarkdownDescription: "Associated storage pool name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyOS: schema.StringAttribute{
 				MarkdownDescription: "The template type (valid options: 'linux', 'windows'). Defaults to **linux**.",
@@ -102,11 +107,7 @@ func templateModelToResource(r *sdk.Template, d *TemplateResourceModel) {
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	if r.Os != nil {
 		d.OS = types.StringPointerValue(r.Os)
 	} else {
@@ -122,7 +123,7 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -136,21 +137,24 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 	// find parent pool
 	poolId, err := getPoolID(ctx, r.Data, data.Pool.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, TemplateResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new template
 	m := templateResourceToModel(data)
 	template, _, err := r.Data.K.PoolAPI.CreateTemplate(ctx, poolId).Template(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, TemplateResourceName, data.Name.ValueString())
 		return
 	}
 	// set template as default
 	if data.Default.ValueBool() {
-		_, err = r.Data.K.PoolAPI.SetStoragePoolDefaultTemplate(ctx, poolId, *template.Id).Execute()
+		err = setDefaultWithRetry(ctx, r.Data.K.PoolAPI.SetStoragePoolDefaultTemplate(ctx, poolId, *template.Id).Execute, func() (bool, error) {
+			pool, _, err := r.Data.K.PoolAPI.ReadStoragePool(ctx, poolId).Execute()
+			return err == nil && pool.DefaultTemplate != nil && *pool.DefaultTemplate == *template.Id, err
+		})
 		if err != nil {
-			errorCreateGeneric(resp, err)
+			errorCreateGeneric(resp, err, TemplateResourceName, data.Name.ValueString())
 			return
 		}
 	}
@@ -168,7 +172,7 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -181,7 +185,7 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	template, _, err := r.Data.K.TemplateAPI.ReadTemplate(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, TemplateResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -195,7 +199,7 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -208,7 +212,7 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 	m := templateResourceToModel(data)
 	_, _, err := r.Data.K.TemplateAPI.UpdateTemplate(ctx, data.ID.ValueString()).Template(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, TemplateResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -222,7 +226,7 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -235,7 +239,7 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	_, err := r.Data.K.TemplateAPI.DeleteTemplate(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, TemplateResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
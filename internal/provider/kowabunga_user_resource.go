@@ -2,15 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
+	"sort"
 	"strings"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,6 +25,10 @@ const (
 
 	UserDefaultValueNotifications = false
 	UserDefaultValueBot           = false
+	UserDefaultValueOtp           = false
+	UserDefaultValueEnabled       = true
+
+	UserRoleSuperAdmin = "superAdmin"
 )
 
 var _ resource.Resource = &UserResource{}
@@ -43,6 +51,11 @@ type UserResourceModel struct {
 	Role          types.String   `tfsdk:"role"`
 	Notifications types.Bool     `tfsdk:"notifications"`
 	Bot           types.Bool     `tfsdk:"bot"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	Otp           types.Bool     `tfsdk:"otp"`
+	OtpSecret     types.String   `tfsdk:"otp_secret"`
+	OtpQRCode     types.String   `tfsdk:"otp_qr_code"`
+	Projects      types.List     `tfsdk:"projects"`
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +71,7 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	projects, _ := types.ListValue(types.StringType, []attr.Value{})
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Kowabunga user resource",
 		Attributes: map[string]schema.Attribute{
@@ -87,23 +101,98 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:            true,
 				Default:             booldefault.StaticBool(UserDefaultValueBot),
 			},
+			KeyEnabled: schema.BoolAttribute{
+				MarkdownDescription: "Whether Kowabunga user account is enabled. Disabling an account revokes its tokens and prevents further logins, without deleting it (default: **true**)",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(UserDefaultValueEnabled),
+			},
+			KeyProjects: schema.ListAttribute{
+				MarkdownDescription: "The list of projects (name or ID) the user is directly associated with, complementing team-based access",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(projects),
+			},
+			KeyOtp: schema.BoolAttribute{
+				MarkdownDescription: "Whether Kowabunga user must authenticate with a one-time password (OTP) as second factor (default: **false**)",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(UserDefaultValueOtp),
+			},
+			KeyOtpSecret: schema.StringAttribute{
+				MarkdownDescription: "Kowabunga user OTP enrollment secret, only available from enrollment time (read-only)",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			KeyOtpQRCode: schema.StringAttribute{
+				MarkdownDescription: "Kowabunga user OTP enrollment QR code, only available from enrollment time (read-only)",
+				Computed:            true,
+				Sensitive:           true,
+			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
+// resolves user projects names or IDs into project IDs
+func getUserProjectIDs(ctx context.Context, data *KowabungaProviderData, d *UserResourceModel) (map[string]string, error) {
+	projects := map[string]string{}
+
+	declared := []string{}
+	d.Projects.ElementsAs(ctx, &declared, false)
+	for _, project := range declared {
+		projectId, err := getProjectID(ctx, data, project)
+		if err != nil {
+			return projects, fmt.Errorf("%s: %s", err.Error(), project)
+		}
+		projects[project] = projectId
+	}
+
+	return projects, nil
+}
+
+// ensures there's at least one other super admin user left
+func userCheckSuperAdminRevoke(ctx context.Context, data *KowabungaProviderData, id string) error {
+	users, _, err := data.K.UserAPI.ListUsers(ctx).Execute()
+	if err != nil {
+		return err
+	}
+	for _, un := range users {
+		if un == id {
+			continue
+		}
+		u, _, err := data.K.UserAPI.ReadUser(ctx, un).Execute()
+		if err == nil && u.Role == UserRoleSuperAdmin {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s", ErrorLastSuperAdmin)
+}
+
 // converts user from Terraform model to Kowabunga API model
-func userResourceToModel(d *UserResourceModel) sdk.User {
+func userResourceToModel(d *UserResourceModel, projects map[string]string) sdk.User {
+	declared := []string{}
+	d.Projects.ElementsAs(context.TODO(), &declared, false)
+	projectIds := []string{}
+	for _, p := range declared {
+		projectIds = append(projectIds, projects[p])
+	}
+	sort.Strings(projectIds)
+
 	return sdk.User{
 		Name:          d.Name.ValueString(),
 		Email:         d.Email.ValueString(),
 		Role:          d.Role.ValueString(),
 		Notifications: d.Notifications.ValueBoolPointer(),
+		Enabled:       d.Enabled.ValueBoolPointer(),
+		Otp:           d.Otp.ValueBoolPointer(),
+		Projects:      projectIds,
 	}
 }
 
 // converts user from Kowabunga API model to Terraform model
-func userModelToResource(r *sdk.User, d *UserResourceModel) {
+func userModelToResource(r *sdk.User, d *UserResourceModel, projects map[string]string) {
 	if r == nil {
 		return
 	}
@@ -116,6 +205,54 @@ func userModelToResource(r *sdk.User, d *UserResourceModel) {
 	} else {
 		d.Notifications = types.BoolValue(UserDefaultValueNotifications)
 	}
+	if r.Enabled != nil {
+		d.Enabled = types.BoolPointerValue(r.Enabled)
+	} else {
+		d.Enabled = types.BoolValue(UserDefaultValueEnabled)
+	}
+	if r.Otp != nil {
+		d.Otp = types.BoolPointerValue(r.Otp)
+	} else {
+		d.Otp = types.BoolValue(UserDefaultValueOtp)
+	}
+	// preserves declared projects names, as long as they resolve to associated projects
+	names := map[string]string{}
+	for name, id := range projects {
+		names[id] = name
+	}
+	userProjects := []string{}
+	for _, p := range r.Projects {
+		name, ok := names[p]
+		if ok {
+			p = name
+		}
+		userProjects = append(userProjects, p)
+	}
+	sort.Strings(userProjects)
+	projectsList := []attr.Value{}
+	for _, p := range userProjects {
+		projectsList = append(projectsList, types.StringValue(p))
+	}
+	d.Projects, _ = types.ListValue(types.StringType, projectsList)
+
+	// OTP enrollment is never read back, only available from enrollment time
+	if d.OtpSecret.IsUnknown() || !d.Otp.ValueBool() {
+		d.OtpSecret = types.StringValue("")
+	}
+	if d.OtpQRCode.IsUnknown() || !d.Otp.ValueBool() {
+		d.OtpQRCode = types.StringValue("")
+	}
+}
+
+// requests server to enroll user for OTP, generating a new secret
+func userOtpEnroll(ctx context.Context, data *KowabungaProviderData, d *UserResourceModel) error {
+	otp, _, err := data.K.UserAPI.SetUserOtp(ctx, d.ID.ValueString()).Execute()
+	if err != nil {
+		return err
+	}
+	d.OtpSecret = types.StringValue(otp.Secret)
+	d.OtpQRCode = types.StringValue(otp.QrCode)
+	return nil
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -124,8 +261,9 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.OtpSecret, data.OtpQRCode)
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -136,27 +274,53 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := userResourceToModel(data)
+	// find associated projects
+	projects, err := getUserProjectIDs(ctx, r.Data, data)
+	if err != nil {
+		errorCreateGeneric(resp, err, UserResourceName, data.Name.ValueString())
+		return
+	}
+	m := userResourceToModel(data, projects)
 	user, _, err := r.Data.K.UserAPI.CreateUser(ctx).User(m).Execute()
+	id, adopted := resourceAdoptExisting(ctx, r.Data, err, UserResourceName, data.Name.ValueString(), getUserID)
+	if adopted {
+		// converge adopted user to planned configuration
+		user, _, err = r.Data.K.UserAPI.UpdateUser(ctx, id).User(m).Execute()
+	}
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, UserResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(user.Id)
-	userModelToResource(user, data) // read back resulting object
+	userModelToResource(user, data, projects) // read back resulting object
+
+	// adopted user keeps its existing credentials and OTP enrollment
+	if adopted {
+		tflog.Trace(ctx, "adopted user resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
 
 	if data.Bot.ValueBool() {
 		// request server to generate a new robot API key, will be sent by email
 		_, err = r.Data.K.UserAPI.SetUserApiToken(ctx, *user.Id).Execute()
 		if err != nil {
-			errorCreateGeneric(resp, err)
+			errorCreateGeneric(resp, err, UserResourceName, data.Name.ValueString())
 			return
 		}
 	} else {
 		// request server to generate a new user password, will be sent by email
 		_, err = r.Data.K.UserAPI.ResetUserPassword(ctx, *user.Id).Execute()
 		if err != nil {
-			errorCreateGeneric(resp, err)
+			errorCreateGeneric(resp, err, UserResourceName, data.Name.ValueString())
+			return
+		}
+	}
+
+	if data.Otp.ValueBool() {
+		err = userOtpEnroll(ctx, r.Data, data)
+		if err != nil {
+			errorCreateGeneric(resp, err, UserResourceName, data.Name.ValueString())
 			return
 		}
 	}
@@ -171,7 +335,8 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	ctx = resourceMaskSensitive(ctx, data.OtpSecret, data.OtpQRCode)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -184,11 +349,12 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	user, _, err := r.Data.K.UserAPI.ReadUser(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, UserResourceName, data.Name.ValueString())
 		return
 	}
 
-	userModelToResource(user, data)
+	projects, _ := getUserProjectIDs(ctx, r.Data, data)
+	userModelToResource(user, data, projects)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -198,8 +364,9 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.OtpSecret, data.OtpQRCode)
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -209,12 +376,44 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
-	m := userResourceToModel(data)
-	_, _, err := r.Data.K.UserAPI.UpdateUser(ctx, data.ID.ValueString()).User(m).Execute()
+	var state *UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// prevent locking everyone out
+	if state.Role.ValueString() == UserRoleSuperAdmin && data.Role.ValueString() != UserRoleSuperAdmin {
+		err := userCheckSuperAdminRevoke(ctx, r.Data, data.ID.ValueString())
+		if err != nil {
+			errorUpdateGeneric(resp, err, UserResourceName, data.Name.ValueString())
+			return
+		}
+	}
+
+	projects, err := getUserProjectIDs(ctx, r.Data, data)
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, UserResourceName, data.Name.ValueString())
 		return
 	}
+	m := userResourceToModel(data, projects)
+	user, _, err := r.Data.K.UserAPI.UpdateUser(ctx, data.ID.ValueString()).User(m).Execute()
+	if err != nil {
+		errorUpdateGeneric(resp, err, UserResourceName, data.Name.ValueString())
+		return
+	}
+	data.OtpSecret = state.OtpSecret
+	data.OtpQRCode = state.OtpQRCode
+	userModelToResource(user, data, projects) // read back resulting object
+
+	// enroll user if OTP has just been enabled
+	if data.Otp.ValueBool() && !state.Otp.ValueBool() {
+		err = userOtpEnroll(ctx, r.Data, data)
+		if err != nil {
+			errorUpdateGeneric(resp, err, UserResourceName, data.Name.ValueString())
+			return
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -225,8 +424,9 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.OtpSecret, data.OtpQRCode)
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -239,7 +439,7 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	_, err := r.Data.K.UserAPI.DeleteUser(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, UserResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
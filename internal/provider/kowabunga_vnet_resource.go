@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -48,7 +50,7 @@ func (r *VNetResource) Metadata(ctx context.Context, req resource.MetadataReques
 }
 
 func (r *VNetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, KeyRegion, getVNetID)
 }
 
 func (r *VNetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,6 +64,9 @@ func (r *VNetResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyVLAN: schema.Int64Attribute{
 				MarkdownDescription: "VLAN ID",
@@ -102,11 +107,7 @@ func vnetModelToResource(r *sdk.VNet, d *VNetResourceModel) {
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	if r.Vlan != nil {
 		d.VLAN = types.Int64PointerValue(r.Vlan)
 	} else {
@@ -123,7 +124,7 @@ func (r *VNetResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -137,14 +138,14 @@ func (r *VNetResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// find parent region
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, VNetResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new virtual network
 	m := vnetResourceToModel(data)
 	vnet, _, err := r.Data.K.RegionAPI.CreateVNet(ctx, regionId).VNet(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, VNetResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(vnet.Id)
@@ -160,7 +161,7 @@ func (r *VNetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -173,7 +174,7 @@ func (r *VNetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	vnet, _, err := r.Data.K.VnetAPI.ReadVNet(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, VNetResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -188,7 +189,7 @@ func (r *VNetResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -202,7 +203,7 @@ func (r *VNetResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	m := vnetResourceToModel(data)
 	_, _, err := r.Data.K.VnetAPI.UpdateVNet(ctx, data.ID.ValueString()).VNet(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, VNetResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -216,7 +217,7 @@ func (r *VNetResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -229,7 +230,7 @@ func (r *VNetResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	_, err := r.Data.K.VnetAPI.DeleteVNet(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, VNetResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -2,13 +2,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
+	"slices"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +24,7 @@ const (
 
 var _ resource.Resource = &VolumeResource{}
 var _ resource.ResourceWithImportState = &VolumeResource{}
+var _ resource.ResourceWithModifyPlan = &VolumeResource{}
 
 func NewVolumeResource() resource.Resource {
 	return &VolumeResource{}
@@ -39,6 +45,8 @@ type VolumeResourceModel struct {
 	Template types.String   `tfsdk:"template"`
 	Type     types.String   `tfsdk:"type"`
 	Size     types.Int64    `tfsdk:"size"`
+	Tags     types.List     `tfsdk:"tags"`
+	Metadata types.Map      `tfsdk:"metadata"`
 }
 
 func (r *VolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,7 +54,7 @@ func (r *VolumeResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *VolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateComposite(ctx, req, resp, KeyProject, KeyRegion)
 }
 
 func (r *VolumeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -60,10 +68,16 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			KeyProject: schema.StringAttribute{
 				MarkdownDescription: "Associated project name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeyPool: schema.StringAttribute{
 				MarkdownDescription: "Associated storage pool name or ID (region's default if unspecified)",
@@ -74,8 +88,11 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 			},
 			KeyTemplate: schema.StringAttribute{
-				MarkdownDescription: "The template name or ID",
+				MarkdownDescription: "The template name or ID. Existing volumes can't be re-imaged, changing it forces volume re-creation, which is refused at plan time as long as volume is attached to an instance.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			KeySize: schema.Int64Attribute{
 				MarkdownDescription: "The volume size (expressed in GB)",
@@ -84,6 +101,58 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
+	maps.Copy(resp.Schema.Attributes, resourceAttributesTags("volume"))
+}
+
+func (r *VolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is being created or destroyed, or provider is not yet configured
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.Data == nil {
+		return
+	}
+
+	var plan, state *VolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an attached volume can't be re-created from another template
+	if plan.Template.IsUnknown() || plan.Template.Equal(state.Template) {
+		return
+	}
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	instance, err := getVolumeInstanceName(ctx, r.Data, state.Project.ValueString(), state.ID.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "unable to check volume attachment: "+err.Error())
+		return
+	}
+	if instance != "" {
+		resp.Diagnostics.AddAttributeError(path.Root(KeyTemplate), ErrorVolumeAttached,
+			fmt.Sprintf("%s: volume %s is attached to instance %s and can't be re-created from another template, detach it first", ErrorVolumeAttached, state.Name.ValueString(), instance))
+	}
+}
+
+// finds the instance a volume is attached to, if any
+func getVolumeInstanceName(ctx context.Context, data *KowabungaProviderData, project string, volumeId string) (string, error) {
+	projectId, err := getProjectID(ctx, data, project)
+	if err != nil {
+		return "", err
+	}
+	instances, _, err := data.K.ProjectAPI.ListProjectInstances(ctx, projectId).Execute()
+	if err != nil {
+		return "", err
+	}
+	for _, id := range instances {
+		instance, _, err := data.K.InstanceAPI.ReadInstance(ctx, id).Execute()
+		if err == nil && slices.Contains(instance.Volumes, volumeId) {
+			return instance.Name, nil
+		}
+	}
+	return "", nil
 }
 
 // converts volume from Terraform model to Kowabunga API model
@@ -93,19 +162,19 @@ func volumeResourceToModel(d *VolumeResourceModel) sdk.Volume {
 		Description: d.Desc.ValueStringPointer(),
 		Type:        d.Type.ValueString(),
 		Size:        d.Size.ValueInt64() * HelperGbToBytes,
+		Tags:        tagsResourceToModel(d.Tags),
+		Metadatas:   metadatasResourceToModel(d.Metadata),
 	}
 }
 
 // converts volume from Kowabunga API model to Terraform model
 func volumeModelToResource(r *sdk.Volume, d *VolumeResourceModel) {
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
 	d.Type = types.StringValue(r.Type)
 	d.Size = types.Int64Value(r.Size / HelperGbToBytes)
+	d.Tags = tagsModelToResource(r.Tags)
+	d.Metadata = metadatasModelToResource(r.Metadatas)
 }
 
 func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -115,7 +184,7 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -129,13 +198,13 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	// find parent project
 	projectId, err := getProjectID(ctx, r.Data, data.Project.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, VolumeResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent region
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, VolumeResourceName, data.Name.ValueString())
 		return
 	}
 	// find parent pool (optional)
@@ -155,7 +224,7 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	volume, _, err := api.Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, VolumeResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(volume.Id)
@@ -171,7 +240,7 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -184,7 +253,7 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	volume, _, err := r.Data.K.VolumeAPI.ReadVolume(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, VolumeResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -198,7 +267,7 @@ func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -211,7 +280,7 @@ func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	m := volumeResourceToModel(data)
 	_, _, err := r.Data.K.VolumeAPI.UpdateVolume(ctx, data.ID.ValueString()).Volume(m).Execute()
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, VolumeResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -225,7 +294,7 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -238,7 +307,7 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	_, err := r.Data.K.VolumeAPI.DeleteVolume(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, VolumeResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	VolumesDataSourceName = "volumes"
+)
+
+var _ datasource.DataSource = &VolumesDataSource{}
+var _ datasource.DataSourceWithConfigure = &VolumesDataSource{}
+
+func NewVolumesDataSource() datasource.DataSource {
+	return &VolumesDataSource{}
+}
+
+type VolumesDataSource struct {
+	Data *KowabungaProviderData
+}
+
+type VolumesDataSourceModel struct {
+	Project types.String                 `tfsdk:"project"`
+	Zone    types.String                 `tfsdk:"zone"`
+	Volumes []VolumesDataSourceItemModel `tfsdk:"volumes"`
+}
+
+type VolumesDataSourceItemModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	Size types.Int64  `tfsdk:"size"`
+}
+
+func (d *VolumesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	datasourceMetadata(req, resp, VolumesDataSourceName)
+}
+
+func (d *VolumesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Data = datasourceConfigure(req, resp)
+}
+
+func (d *VolumesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data from volumes, optionally filtered by project and/or zone",
+		Attributes: map[string]schema.Attribute{
+			KeyProject: schema.StringAttribute{
+				MarkdownDescription: "Associated project name or ID to filter volumes from",
+				Optional:            true,
+			},
+			KeyZone: schema.StringAttribute{
+				MarkdownDescription: "Associated zone name or ID to filter volumes from. Volumes being regional resources, all volumes from zone's parent region are returned",
+				Optional:            true,
+			},
+			KeyVolumes: schema.ListNestedAttribute{
+				MarkdownDescription: "List of Kowabunga volumes, sorted by name (read-only)",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						KeyID: schema.StringAttribute{
+							MarkdownDescription: "Volume internal identifier (read-only)",
+							Computed:            true,
+						},
+						KeyName: schema.StringAttribute{
+							MarkdownDescription: "Volume name (read-only)",
+							Computed:            true,
+						},
+						KeyType: schema.StringAttribute{
+							MarkdownDescription: "Volume type, 'os', 'iso' or 'raw' (read-only)",
+							Computed:            true,
+						},
+						KeySize: schema.Int64Attribute{
+							MarkdownDescription: "Volume size, expressed in GB (read-only)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// lists volumes IDs, narrowed down to project and/or region ones
+func volumesList(ctx context.Context, data *KowabungaProviderData, projectId string, regionId string) ([]string, error) {
+	var ids []string
+	var err error
+	switch {
+	case projectId != "" && regionId != "":
+		ids, _, err = data.K.ProjectAPI.ListProjectRegionVolumes(ctx, projectId, regionId).Execute()
+	case projectId != "":
+		ids, _, err = data.K.ProjectAPI.ListProjectVolumes(ctx, projectId).Execute()
+	case regionId != "":
+		ids, _, err = data.K.RegionAPI.ListRegionVolumes(ctx, regionId).Execute()
+	default:
+		ids, _, err = data.K.VolumeAPI.ListVolumes(ctx).Execute()
+	}
+	return ids, err
+}
+
+func (d *VolumesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.Data.Mutex.Lock()
+	defer d.Data.Mutex.Unlock()
+
+	projectId := ""
+	if data.Project.ValueString() != "" {
+		id, err := getProjectID(ctx, d.Data, data.Project.ValueString())
+		if err != nil {
+			errorDataSourceReadGeneric(resp, err)
+			return
+		}
+		projectId = id
+	}
+
+	// volumes are regional, look up zone's parent region
+	regionId := ""
+	if data.Zone.ValueString() != "" {
+		zoneId, err := getZoneID(ctx, d.Data, data.Zone.ValueString())
+		if err != nil {
+			errorDataSourceReadGeneric(resp, err)
+			return
+		}
+		regionId, err = getZoneRegionID(ctx, d.Data, zoneId)
+		if err != nil {
+			errorDataSourceReadGeneric(resp, err)
+			return
+		}
+	}
+
+	volumes, err := volumesList(ctx, d.Data, projectId, regionId)
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	data.Volumes = []VolumesDataSourceItemModel{}
+	for _, id := range volumes {
+		volume, _, err := d.Data.K.VolumeAPI.ReadVolume(ctx, id).Execute()
+		if err != nil {
+			continue
+		}
+		data.Volumes = append(data.Volumes, VolumesDataSourceItemModel{
+			ID:   types.StringPointerValue(volume.Id),
+			Name: types.StringValue(volume.Name),
+			Type: types.StringValue(volume.Type),
+			Size: types.Int64Value(volume.Size / HelperGbToBytes),
+		})
+	}
+	slices.SortFunc(data.Volumes, func(a, b VolumesDataSourceItemModel) int {
+		return cmp.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
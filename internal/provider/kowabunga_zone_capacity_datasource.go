@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ZoneCapacityDataSourceName = "zone_capacity"
+)
+
+var _ datasource.DataSource = &ZoneCapacityDataSource{}
+var _ datasource.DataSourceWithConfigure = &ZoneCapacityDataSource{}
+
+func NewZoneCapacityDataSource() datasource.DataSource {
+	return &ZoneCapacityDataSource{}
+}
+
+type ZoneCapacityDataSource struct {
+	Data *KowabungaProviderData
+}
+
+type ZoneCapacityDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Zone             types.String `tfsdk:"zone"`
+	Hosts            types.Int64  `tfsdk:"hosts"`
+	VCPUsTotal       types.Int64  `tfsdk:"vcpus_total"`
+	VCPUsAllocated   types.Int64  `tfsdk:"vcpus_allocated"`
+	MemoryTotal      types.Int64  `tfsdk:"mem_total"`
+	MemoryAllocated  types.Int64  `tfsdk:"mem_allocated"`
+	StorageTotal     types.Int64  `tfsdk:"storage_total"`
+	StorageAllocated types.Int64  `tfsdk:"storage_allocated"`
+	StorageAvailable types.Int64  `tfsdk:"storage_available"`
+}
+
+// zone-level aggregated capacity
+type zoneCapacity struct {
+	hosts            int64
+	vcpusTotal       int64
+	vcpusAllocated   int64
+	memoryTotal      int64
+	memoryAllocated  int64
+	storageTotal     int64
+	storageAllocated int64
+}
+
+func (d *ZoneCapacityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	datasourceMetadata(req, resp, ZoneCapacityDataSourceName)
+}
+
+func (d *ZoneCapacityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Data = datasourceConfigure(req, resp)
+}
+
+func (d *ZoneCapacityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data from a zone's aggregated capacity, across all of its Kaktus hosts and its parent region's storage pools",
+		Attributes: map[string]schema.Attribute{
+			KeyID: schema.StringAttribute{
+				MarkdownDescription: "Zone internal identifier (read-only)",
+				Computed:            true,
+			},
+			KeyZone: schema.StringAttribute{
+				MarkdownDescription: "Zone name or ID",
+				Required:            true,
+			},
+			KeyHosts: schema.Int64Attribute{
+				MarkdownDescription: "Number of Kaktus hosts in zone (read-only)",
+				Computed:            true,
+			},
+			KeyVCPUsTotal: schema.Int64Attribute{
+				MarkdownDescription: "Total number of vCPUs in zone, honoring hosts CPU overcommit ratio (read-only)",
+				Computed:            true,
+			},
+			KeyVCPUsAllocated: schema.Int64Attribute{
+				MarkdownDescription: "Number of vCPUs allocated to zone's instances (read-only)",
+				Computed:            true,
+			},
+			KeyMemoryTotal: schema.Int64Attribute{
+				MarkdownDescription: "Total memory size in zone, honoring hosts memory overcommit ratio, expressed in GB (read-only)",
+				Computed:            true,
+			},
+			KeyMemoryAllocated: schema.Int64Attribute{
+				MarkdownDescription: "Memory size allocated to zone's instances, expressed in GB (read-only)",
+				Computed:            true,
+			},
+			KeyStorageTotal: schema.Int64Attribute{
+				MarkdownDescription: "Total storage size of zone's region storage pools, expressed in GB (read-only)",
+				Computed:            true,
+			},
+			KeyStorageAllocated: schema.Int64Attribute{
+				MarkdownDescription: "Storage size allocated from zone's region storage pools, expressed in GB (read-only)",
+				Computed:            true,
+			},
+			KeyStorageAvailable: schema.Int64Attribute{
+				MarkdownDescription: "Storage size still available from zone's region storage pools, expressed in GB (read-only)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// sums up zone's Kaktus hosts computing resources, with overcommit
+func zoneCapacityCompute(ctx context.Context, data *KowabungaProviderData, zoneId string, c *zoneCapacity) error {
+	nodes, _, err := data.K.ZoneAPI.ListZoneKaktuses(ctx, zoneId).Execute()
+	if err != nil {
+		return err
+	}
+	for _, id := range nodes {
+		kaktus, _, err := data.K.KaktusAPI.ReadKaktus(ctx, id).Execute()
+		if err != nil {
+			return err
+		}
+		caps, _, err := data.K.KaktusAPI.ReadKaktusCaps(ctx, id).Execute()
+		if err != nil {
+			return err
+		}
+
+		var cpuOvercommit int64 = KaktusDefaultValueCpuOverCommit
+		if kaktus.OvercommitCpuRatio != nil {
+			cpuOvercommit = *kaktus.OvercommitCpuRatio
+		}
+		var memoryOvercommit int64 = KaktusDefaultValueMemoryOverCommit
+		if kaktus.OvercommitMemoryRatio != nil {
+			memoryOvercommit = *kaktus.OvercommitMemoryRatio
+		}
+
+		c.hosts++
+		c.vcpusTotal += caps.Cpu.Cores * cpuOvercommit
+		c.memoryTotal += caps.Memory * memoryOvercommit
+	}
+
+	instances, _, err := data.K.ZoneAPI.ListZoneInstances(ctx, zoneId).Execute()
+	if err != nil {
+		return err
+	}
+	for _, id := range instances {
+		instance, _, err := data.K.InstanceAPI.ReadInstance(ctx, id).Execute()
+		if err != nil {
+			continue
+		}
+		c.vcpusAllocated += instance.Vcpus
+		c.memoryAllocated += instance.Memory
+	}
+
+	return nil
+}
+
+// sums up zone's parent region storage pools capacity
+func zoneCapacityStorage(ctx context.Context, data *KowabungaProviderData, zoneId string, c *zoneCapacity) error {
+	regionId, err := getZoneRegionID(ctx, data, zoneId)
+	if err != nil {
+		return err
+	}
+	pools, _, err := data.K.RegionAPI.ListRegionStoragePools(ctx, regionId).Execute()
+	if err != nil {
+		return err
+	}
+	for _, id := range pools {
+		usage, _, err := data.K.PoolAPI.ReadStoragePoolUsage(ctx, id).Execute()
+		if err != nil {
+			return err
+		}
+		c.storageTotal += usage.Capacity
+		c.storageAllocated += usage.Allocated
+	}
+
+	return nil
+}
+
+func (d *ZoneCapacityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneCapacityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.Data.Mutex.Lock()
+	defer d.Data.Mutex.Unlock()
+
+	zoneId, err := getZoneID(ctx, d.Data, data.Zone.ValueString())
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	c := zoneCapacity{}
+	err = zoneCapacityCompute(ctx, d.Data, zoneId, &c)
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+	err = zoneCapacityStorage(ctx, d.Data, zoneId, &c)
+	if err != nil {
+		errorDataSourceReadGeneric(resp, err)
+		return
+	}
+
+	data.ID = types.StringValue(zoneId)
+	data.Hosts = types.Int64Value(c.hosts)
+	data.VCPUsTotal = types.Int64Value(c.vcpusTotal)
+	data.VCPUsAllocated = types.Int64Value(c.vcpusAllocated)
+	data.MemoryTotal = types.Int64Value(c.memoryTotal / HelperGbToBytes)
+	data.MemoryAllocated = types.Int64Value(c.memoryAllocated / HelperGbToBytes)
+	data.StorageTotal = types.Int64Value(c.storageTotal / HelperGbToBytes)
+	data.StorageAllocated = types.Int64Value(c.storageAllocated / HelperGbToBytes)
+	data.StorageAvailable = types.Int64Value(max(c.storageTotal-c.storageAllocated, 0) / HelperGbToBytes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	ZoneDefaultsResourceName = "zone_defaults"
+)
+
+var _ resource.Resource = &ZoneDefaultsResource{}
+var _ resource.ResourceWithImportState = &ZoneDefaultsResource{}
+
+func NewZoneDefaultsResource() resource.Resource {
+	return &ZoneDefaultsResource{}
+}
+
+type ZoneDefaultsResource struct {
+	Data *KowabungaProviderData
+}
+
+type ZoneDefaultsResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Zone     types.String   `tfsdk:"zone"`
+	Pool     types.String   `tfsdk:"default_pool"`
+	Nfs      types.String   `tfsdk:"default_nfs"`
+	Template types.String   `tfsdk:"default_template"`
+}
+
+func (r *ZoneDefaultsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resourceMetadata(req, resp, ZoneDefaultsResourceName)
+}
+
+func (r *ZoneDefaultsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// zone defaults are identified by their zone
+	resourceImportState(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root(KeyZone), req, resp)
+}
+
+func (r *ZoneDefaultsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Data = resourceConfigure(req, resp)
+}
+
+func (r *ZoneDefaultsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a zone's default storage pool, NFS storage and volume template altogether, in a single reconciled resource. It removes any ordering dependency between the per-resource **default** flags. Should not be used along with the zone's resource own defaults attributes. Destroying the resource leaves the zone's defaults as they are.",
+		Attributes: map[string]schema.Attribute{
+			KeyZone: schema.StringAttribute{
+				MarkdownDescription: "Associated zone name or ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyDefaultPool: schema.StringAttribute{
+				MarkdownDescription: "Zone's default storage pool name or ID. Left unmanaged if unset",
+				Optional:            true,
+			},
+			KeyDefaultNfs: schema.StringAttribute{
+				MarkdownDescription: "Zone's default NFS storage name or ID. Left unmanaged if unset",
+				Optional:            true,
+			},
+			KeyDefaultTemplate: schema.StringAttribute{
+				MarkdownDescription: "Zone's default volume template name or ID. Left unmanaged if unset",
+				Optional:            true,
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, resourceAttributesWithoutName(&ctx))
+	// zone defaults are no real object, there's nothing to describe
+	delete(resp.Schema.Attributes, KeyDesc)
+}
+
+// resolves and applies zone's declared defaults
+func (r *ZoneDefaultsResource) Apply(ctx context.Context, data *ZoneDefaultsResourceModel) error {
+	zoneId, err := getZoneID(ctx, r.Data, data.Zone.ValueString())
+	if err != nil {
+		return err
+	}
+	defaults, err := getZoneDefaultIDs(ctx, r.Data, data.Pool, data.Nfs, data.Template)
+	if err != nil {
+		return err
+	}
+	err = zoneSetDefaults(ctx, r.Data, zoneId, defaults)
+	if err != nil {
+		return err
+	}
+	data.ID = types.StringValue(zoneId)
+
+	return nil
+}
+
+func (r *ZoneDefaultsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ZoneDefaultsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	err := r.Apply(ctx, data)
+	if err != nil {
+		errorCreateGeneric(resp, err, ZoneDefaultsResourceName, data.Zone.ValueString())
+		return
+	}
+	tflog.Trace(ctx, "created zone defaults resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneDefaultsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ZoneDefaultsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	zone, _, err := r.Data.K.ZoneAPI.ReadZone(ctx, data.ID.ValueString()).Execute()
+	if err != nil {
+		errorReadGeneric(resp, err, ZoneDefaultsResourceName, data.Zone.ValueString())
+		return
+	}
+
+	// unresolvable defaults are reported as drift
+	defaults, _ := getZoneDefaultIDs(ctx, r.Data, data.Pool, data.Nfs, data.Template)
+
+	data.Pool = zoneDefaultToResource(data.Pool, zone.DefaultStoragePool, defaults[KeyDefaultPool])
+	data.Nfs = zoneDefaultToResource(data.Nfs, zone.DefaultStorageNfs, defaults[KeyDefaultNfs])
+	data.Template = zoneDefaultToResource(data.Template, zone.DefaultTemplate, defaults[KeyDefaultTemplate])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneDefaultsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ZoneDefaultsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.Data.Mutex.Lock()
+	defer r.Data.Mutex.Unlock()
+
+	err := r.Apply(ctx, data)
+	if err != nil {
+		errorUpdateGeneric(resp, err, ZoneDefaultsResourceName, data.Zone.ValueString())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneDefaultsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ZoneDefaultsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// there's no way to unset a zone default, leave it as-is
+	tflog.Trace(ctx, "Released "+data.ID.ValueString()+" zone defaults")
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
@@ -9,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -34,6 +37,9 @@ type ZoneResourceModel struct {
 	Name     types.String   `tfsdk:"name"`
 	Desc     types.String   `tfsdk:"desc"`
 	Region   types.String   `tfsdk:"region"`
+	Pool     types.String   `tfsdk:"default_pool"`
+	Nfs      types.String   `tfsdk:"default_nfs"`
+	Template types.String   `tfsdk:"default_template"`
 }
 
 func (r *ZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,7 +47,7 @@ func (r *ZoneResource) Metadata(ctx context.Context, req resource.MetadataReques
 }
 
 func (r *ZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportState(ctx, req, resp)
+	resourceImportStateByName(ctx, req, resp, r.Data, KeyRegion, getZoneID)
 }
 
 func (r *ZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,12 +61,107 @@ func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			KeyRegion: schema.StringAttribute{
 				MarkdownDescription: "Associated region name or ID",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			KeyDefaultPool: schema.StringAttribute{
+				MarkdownDescription: "Zone's default storage pool name or ID. Left unmanaged if unset",
+				Optional:            true,
+			},
+			KeyDefaultNfs: schema.StringAttribute{
+				MarkdownDescription: "Zone's default NFS storage name or ID. Left unmanaged if unset",
+				Optional:            true,
+			},
+			KeyDefaultTemplate: schema.StringAttribute{
+				MarkdownDescription: "Zone's default volume template name or ID. Left unmanaged if unset",
+				Optional:            true,
 			},
 		},
 	}
 	maps.Copy(resp.Schema.Attributes, resourceAttributes(&ctx))
 }
 
+// resolves zone's declared default references into IDs
+func getZoneDefaultIDs(ctx context.Context, data *KowabungaProviderData, pool types.String, nfs types.String, template types.String) (map[string]string, error) {
+	defaults := map[string]string{}
+
+	if !pool.IsNull() {
+		poolId, err := getPoolID(ctx, data, pool.ValueString())
+		if err != nil {
+			return defaults, fmt.Errorf("%s: %s", err.Error(), pool.ValueString())
+		}
+		defaults[KeyDefaultPool] = poolId
+	}
+
+	if !nfs.IsNull() {
+		nfsId, err := getNfsID(ctx, data, nfs.ValueString())
+		if err != nil {
+			return defaults, fmt.Errorf("%s: %s", err.Error(), nfs.ValueString())
+		}
+		defaults[KeyDefaultNfs] = nfsId
+	}
+
+	if !template.IsNull() {
+		templateId, err := getTemplateID(ctx, data, template.ValueString())
+		if err != nil {
+			return defaults, fmt.Errorf("%s: %s", err.Error(), template.ValueString())
+		}
+		defaults[KeyDefaultTemplate] = templateId
+	}
+
+	return defaults, nil
+}
+
+// sets zone's declared defaults
+func zoneSetDefaults(ctx context.Context, data *KowabungaProviderData, zoneId string, defaults map[string]string) error {
+	if poolId, ok := defaults[KeyDefaultPool]; ok {
+		err := setDefaultWithRetry(ctx, data.K.ZoneAPI.SetZoneDefaultStoragePool(ctx, zoneId, poolId).Execute, func() (bool, error) {
+			zone, _, err := data.K.ZoneAPI.ReadZone(ctx, zoneId).Execute()
+			return err == nil && zone.DefaultStoragePool != nil && *zone.DefaultStoragePool == poolId, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if nfsId, ok := defaults[KeyDefaultNfs]; ok {
+		err := setDefaultWithRetry(ctx, data.K.ZoneAPI.SetZoneDefaultStorageNFS(ctx, zoneId, nfsId).Execute, func() (bool, error) {
+			zone, _, err := data.K.ZoneAPI.ReadZone(ctx, zoneId).Execute()
+			return err == nil && zone.DefaultStorageNfs != nil && *zone.DefaultStorageNfs == nfsId, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if templateId, ok := defaults[KeyDefaultTemplate]; ok {
+		err := setDefaultWithRetry(ctx, data.K.ZoneAPI.SetZoneDefaultTemplate(ctx, zoneId, templateId).Execute, func() (bool, error) {
+			zone, _, err := data.K.ZoneAPI.ReadZone(ctx, zoneId).Execute()
+			return err == nil && zone.DefaultTemplate != nil && *zone.DefaultTemplate == templateId, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reads back zone's default reference, keeping declared spelling if unchanged
+func zoneDefaultToResource(declared types.String, current *string, resolved string) types.String {
+	if declared.IsNull() {
+		return declared
+	}
+	if current != nil && *current == resolved {
+		return declared
+	}
+	if current != nil {
+		return types.StringPointerValue(current)
+	}
+	return types.StringValue("")
+}
+
 // converts zone from Terraform model to Kowabunga API model
 func zoneResourceToModel(d *ZoneResourceModel) sdk.Zone {
 	return sdk.Zone{
@@ -70,17 +171,16 @@ func zoneResourceToModel(d *ZoneResourceModel) sdk.Zone {
 }
 
 // converts zone from Kowabunga API model to Terraform model
-func zoneModelToResource(r *sdk.Zone, d *ZoneResourceModel) {
+func zoneModelToResource(r *sdk.Zone, d *ZoneResourceModel, defaults map[string]string) {
 	if r == nil {
 		return
 	}
 
 	d.Name = types.StringValue(r.Name)
-	if r.Description != nil {
-		d.Desc = types.StringPointerValue(r.Description)
-	} else {
-		d.Desc = types.StringValue("")
-	}
+	d.Desc = descModelToResource(r.Description)
+	d.Pool = zoneDefaultToResource(d.Pool, r.DefaultStoragePool, defaults[KeyDefaultPool])
+	d.Nfs = zoneDefaultToResource(d.Nfs, r.DefaultStorageNfs, defaults[KeyDefaultNfs])
+	d.Template = zoneDefaultToResource(d.Template, r.DefaultTemplate, defaults[KeyDefaultTemplate])
 }
 
 func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -90,7 +190,7 @@ func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Create(ctx, DefaultCreateTimeout)
+	timeout, diags := data.Timeouts.Create(ctx, r.Data.CreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -104,18 +204,36 @@ func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// find parent region
 	regionId, err := getRegionID(ctx, r.Data, data.Region.ValueString())
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
+		return
+	}
+	// find zone defaults
+	defaults, err := getZoneDefaultIDs(ctx, r.Data, data.Pool, data.Nfs, data.Template)
+	if err != nil {
+		errorCreateGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
 		return
 	}
 	// create a new zone
 	m := zoneResourceToModel(data)
 	zone, _, err := r.Data.K.RegionAPI.CreateZone(ctx, regionId).Zone(m).Execute()
 	if err != nil {
-		errorCreateGeneric(resp, err)
+		errorCreateGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
 		return
 	}
 	data.ID = types.StringPointerValue(zone.Id)
-	zoneModelToResource(zone, data) // read back resulting object
+
+	// set zone defaults
+	err = zoneSetDefaults(ctx, r.Data, *zone.Id, defaults)
+	if err != nil {
+		errorCreateGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
+		return
+	}
+	zone, _, err = r.Data.K.ZoneAPI.ReadZone(ctx, *zone.Id).Execute()
+	if err != nil {
+		errorCreateGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
+		return
+	}
+	zoneModelToResource(zone, data, defaults) // read back resulting object
 	tflog.Trace(ctx, "created zone resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -127,7 +245,7 @@ func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	timeout, diags := data.Timeouts.Read(ctx, DefaultReadTimeout)
+	timeout, diags := data.Timeouts.Read(ctx, r.Data.ReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -140,11 +258,14 @@ func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	zone, _, err := r.Data.K.ZoneAPI.ReadZone(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorReadGeneric(resp, err)
+		errorReadGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
 		return
 	}
 
-	zoneModelToResource(zone, data)
+	// unresolvable defaults are reported as drift
+	defaults, _ := getZoneDefaultIDs(ctx, r.Data, data.Pool, data.Nfs, data.Template)
+
+	zoneModelToResource(zone, data, defaults)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -154,7 +275,7 @@ func (r *ZoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	timeout, diags := data.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	timeout, diags := data.Timeouts.Update(ctx, r.Data.UpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -165,10 +286,22 @@ func (r *ZoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	r.Data.Mutex.Lock()
 	defer r.Data.Mutex.Unlock()
 
+	defaults, err := getZoneDefaultIDs(ctx, r.Data, data.Pool, data.Nfs, data.Template)
+	if err != nil {
+		errorUpdateGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
+		return
+	}
+
 	m := zoneResourceToModel(data)
-	_, _, err := r.Data.K.ZoneAPI.UpdateZone(ctx, data.ID.ValueString()).Zone(m).Execute()
+	_, _, err = r.Data.K.ZoneAPI.UpdateZone(ctx, data.ID.ValueString()).Zone(m).Execute()
+	if err != nil {
+		errorUpdateGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
+		return
+	}
+
+	err = zoneSetDefaults(ctx, r.Data, data.ID.ValueString(), defaults)
 	if err != nil {
-		errorUpdateGeneric(resp, err)
+		errorUpdateGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
 		return
 	}
 
@@ -182,7 +315,7 @@ func (r *ZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	timeout, diags := data.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	timeout, diags := data.Timeouts.Delete(ctx, r.Data.DeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -195,7 +328,7 @@ func (r *ZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	_, err := r.Data.K.ZoneAPI.DeleteZone(ctx, data.ID.ValueString()).Execute()
 	if err != nil {
-		errorDeleteGeneric(resp, err)
+		errorDeleteGeneric(resp, err, ZoneResourceName, data.Name.ValueString())
 		return
 	}
 	tflog.Trace(ctx, "Deleted "+data.ID.ValueString())
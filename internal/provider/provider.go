@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"net/url"
 	"sync"
+	"time"
 
 	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,19 +21,35 @@ import (
 const (
 	ProviderName = "kowabunga"
 	MimeJSON     = "application/json"
+
+	ProviderDefaultValueNotify        = true
+	ProviderDefaultValueAdoptExisting = false
 )
 
 var _ provider.Provider = &KowabungaProvider{}
+var _ provider.ProviderWithFunctions = &KowabungaProvider{}
 
 type KowabungaProviderModel struct {
-	URI   types.String `tfsdk:"uri"`
-	Token types.String `tfsdk:"token"`
+	URI           types.String `tfsdk:"uri"`
+	Token         types.String `tfsdk:"token"`
+	Notify        types.Bool   `tfsdk:"default_notify"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	CreateTimeout types.String `tfsdk:"default_create_timeout"`
+	ReadTimeout   types.String `tfsdk:"default_read_timeout"`
+	UpdateTimeout types.String `tfsdk:"default_update_timeout"`
+	DeleteTimeout types.String `tfsdk:"default_delete_timeout"`
 }
 
 type KowabungaProviderData struct {
-	K     *sdk.APIClient
-	Mutex *sync.Mutex
-	Cond  *sync.Cond
+	K             *sdk.APIClient
+	Mutex         *sync.Mutex
+	Cond          *sync.Cond
+	Notify        bool
+	AdoptExisting bool
+	CreateTimeout time.Duration
+	ReadTimeout   time.Duration
+	UpdateTimeout time.Duration
+	DeleteTimeout time.Duration
 }
 
 type KowabungaProvider struct {
@@ -67,10 +86,49 @@ func (p *KowabungaProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Required:            true,
 				Sensitive:           true,
 			},
+			KeyDefaultNotify: schema.BoolAttribute{
+				MarkdownDescription: "Default value of the `notify` attribute of project, Kompute and instance resources, when not explicitly set (default: **true**). Set to **false** to globally suppress email notifications, e.g. in CI runs.",
+				Optional:            true,
+			},
+			KeyAdoptExisting: schema.BoolAttribute{
+				MarkdownDescription: "Whether to adopt already existing uniquely-named objects (i.e. projects, regions and users) into state upon creation conflict, instead of failing, e.g. after a partially failed apply (default: **false**). Adopted objects are updated with the planned configuration.",
+				Optional:            true,
+			},
+			KeyDefaultCreateTimeout: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Default resources creation timeout, unless overridden in resource's `timeouts` block (default: **%s**). Expressed as a duration string, e.g. \"45m\" or \"1h30m\".", DefaultCreateTimeout),
+				Optional:            true,
+			},
+			KeyDefaultReadTimeout: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Default resources read timeout, unless overridden in resource's `timeouts` block (default: **%s**). Expressed as a duration string, e.g. \"45m\" or \"1h30m\".", DefaultReadTimeout),
+				Optional:            true,
+			},
+			KeyDefaultUpdateTimeout: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Default resources update timeout, unless overridden in resource's `timeouts` block (default: **%s**). Expressed as a duration string, e.g. \"45m\" or \"1h30m\".", DefaultUpdateTimeout),
+				Optional:            true,
+			},
+			KeyDefaultDeleteTimeout: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Default resources deletion timeout, unless overridden in resource's `timeouts` block (default: **%s**). Expressed as a duration string, e.g. \"45m\" or \"1h30m\".", DefaultDeleteTimeout),
+				Optional:            true,
+			},
 		},
 	}
 }
 
+// parses provider-level default timeout, falling back to built-in one when unset
+func providerTimeout(resp *provider.ConfigureResponse, key string, value types.String, fallback time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root(key), ErrorInvalidTimeout,
+			fmt.Sprintf("%s: %q is not a valid positive duration", ErrorInvalidTimeout, value.ValueString()))
+		return fallback
+	}
+	return timeout
+}
+
 func newKowabungaClient(uri, token string) (*sdk.APIClient, error) {
 	if uri == "" || token == "" {
 		return nil, fmt.Errorf("The Kowabunga provider needs proper initialization parameters")
@@ -102,6 +160,7 @@ func (p *KowabungaProvider) Configure(ctx context.Context, req provider.Configur
 		resp.Diagnostics.AddError("Unknown Value", "An attribute value is not yet known")
 		return
 	}
+	ctx = resourceMaskSensitive(ctx, data.Token)
 
 	k, err := newKowabungaClient(data.URI.ValueString(), data.Token.ValueString())
 	if err != nil {
@@ -109,11 +168,30 @@ func (p *KowabungaProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	notify := ProviderDefaultValueNotify
+	if !data.Notify.IsNull() && !data.Notify.IsUnknown() {
+		notify = data.Notify.ValueBool()
+	}
+
+	adopt := ProviderDefaultValueAdoptExisting
+	if !data.AdoptExisting.IsNull() && !data.AdoptExisting.IsUnknown() {
+		adopt = data.AdoptExisting.ValueBool()
+	}
+
 	var mut sync.Mutex
 	var d = KowabungaProviderData{
-		K:     k,
-		Mutex: &mut,
-		Cond:  sync.NewCond(&mut),
+		K:             k,
+		Mutex:         &mut,
+		Cond:          sync.NewCond(&mut),
+		Notify:        notify,
+		AdoptExisting: adopt,
+		CreateTimeout: providerTimeout(resp, KeyDefaultCreateTimeout, data.CreateTimeout, DefaultCreateTimeout),
+		ReadTimeout:   providerTimeout(resp, KeyDefaultReadTimeout, data.ReadTimeout, DefaultReadTimeout),
+		UpdateTimeout: providerTimeout(resp, KeyDefaultUpdateTimeout, data.UpdateTimeout, DefaultUpdateTimeout),
+		DeleteTimeout: providerTimeout(resp, KeyDefaultDeleteTimeout, data.DeleteTimeout, DefaultDeleteTimeout),
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	p.Data = &d
@@ -126,37 +204,55 @@ func (p *KowabungaProvider) Resources(ctx context.Context) []func() resource.Res
 		NewAdapterResource,
 		NewAgentResource,
 		NewDnsRecordResource,
+		NewDnsRecordsResource,
 		NewInstanceResource,
 		NewKaktusResource,
 		NewKawaiiIPsecResource,
 		NewKawaiiResource,
+		NewKawaiiVpcPeeringResource,
 		NewKiwiResource,
 		NewKomputeResource,
 		NewKonveyResource,
 		NewKyloResource,
+		NewKyloSnapshotResource,
 		NewProjectResource,
 		NewRegionResource,
 		NewStorageNfsResource,
 		NewStoragePoolResource,
 		NewSubnetResource,
 		NewTeamResource,
+		NewTemplateFromInstanceResource,
 		NewTemplateResource,
 		NewUserResource,
 		NewVNetResource,
 		NewVolumeResource,
+		NewZoneDefaultsResource,
 		NewZoneResource,
 	}
 }
 
 func (p *KowabungaProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewCurrentUserDataSource,
+		NewInstancesDataSource,
+		NewIPAllocationDataSource,
 		NewRegionDataSource,
 		NewRegionsDataSource,
 		NewSubnetDataSource,
+		NewSubnetNextFreeRangeDataSource,
 		NewSubnetsDataSource,
 		NewTeamDataSource,
 		NewTeamsDataSource,
+		NewVolumesDataSource,
+		NewZoneCapacityDataSource,
 		NewZoneDataSource,
 		NewZonesDataSource,
 	}
 }
+
+func (p *KowabungaProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewResolveIdFunction(p),
+		NewValidPortsFunction,
+	}
+}
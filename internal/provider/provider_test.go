@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+const (
+	// acceptance tests environment
+	testAccEnvURI    = "KOWABUNGA_URI"
+	testAccEnvToken  = "KOWABUNGA_TOKEN"
+	testAccEnvSubnet = "KOWABUNGA_SUBNET"
+)
+
+// instantiates the provider during acceptance testing, Terraform CLI
+// connecting to it through the reattach mechanism
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	ProviderName: providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// ensures acceptance tests environment is set, acceptance tests themselves
+// being skipped unless TF_ACC is
+func testAccPreCheck(t *testing.T, env ...string) {
+	for _, e := range append([]string{testAccEnvURI, testAccEnvToken}, env...) {
+		if os.Getenv(e) == "" {
+			t.Fatalf("%s must be set for acceptance tests", e)
+		}
+	}
+}
+
+// returns provider configuration, from acceptance tests environment
+func testAccProviderConfig() string {
+	return `
+provider "kowabunga" {
+  uri   = "` + os.Getenv(testAccEnvURI) + `"
+  token = "` + os.Getenv(testAccEnvToken) + `"
+}
+`
+}
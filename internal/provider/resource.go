@@ -4,23 +4,36 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"net/http"
+	"slices"
+	"strings"
 	"time"
 
+	sdk "github.com/dalet-oss/kowabunga-api/sdk/go"
+
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	KeyAccessType                 = "access_type"
+	KeyAction                     = "action"
 	KeyAdapters                   = "adapters"
+	KeyAllowedClients             = "allowed_clients"
 	KeyAddress                    = "address"
 	KeyAddresses                  = "addresses"
+	KeyAdoptExisting              = "adopt_existing"
 	KeyAgents                     = "agents"
 	KeyApp                        = "app"
 	KeyApplication                = "application"
@@ -28,33 +41,61 @@ const (
 	KeyBackendIPs                 = "backend_ips"
 	KeyBackendPort                = "backend_port"
 	KeyBackends                   = "backends"
+	KeyBootstrapGroups            = "bootstrap_groups"
 	KeyBootstrapPubkey            = "bootstrap_pubkey"
+	KeyBootstrapSudo              = "bootstrap_sudo"
 	KeyBootstrapUser              = "bootstrap_user"
 	KeyBot                        = "bot"
+	KeyBytes                      = "bytes"
 	KeyCIDR                       = "cidr"
 	KeyCpuOvercommit              = "cpu_overcommit"
 	KeyCpuPrice                   = "cpu_price"
 	KeyCurrency                   = "currency"
 	KeyDefault                    = "default"
+	KeyDefaultCreateTimeout       = "default_create_timeout"
+	KeyDefaultDeleteTimeout       = "default_delete_timeout"
+	KeyDefaultNfs                 = "default_nfs"
+	KeyDefaultNotify              = "default_notify"
+	KeyDefaultPool                = "default_pool"
+	KeyDefaultReadTimeout         = "default_read_timeout"
+	KeyDefaultTemplate            = "default_template"
+	KeyDefaultUpdateTimeout       = "default_update_timeout"
+	KeyDefaultZone                = "default_zone"
 	KeyDesc                       = "desc"
 	KeyDestination                = "destination"
 	KeyDisk                       = "disk"
 	KeyDNS                        = "dns"
 	KeyDomain                     = "domain"
+	KeyEffectiveBootstrapPubkey   = "effective_bootstrap_pubkey"
+	KeyEffectiveBootstrapUser     = "effective_bootstrap_user"
+	KeyEffectiveEgressPolicy      = "effective_egress_policy"
 	KeyEgressPolicy               = "egress_policy"
 	KeyEgressRules                = "egress_rules"
 	KeyEmail                      = "email"
+	KeyEnabled                    = "enabled"
 	KeyEndpoint                   = "endpoint"
 	KeyEndpoints                  = "endpoints"
+	KeyError                      = "error"
 	KeyExtraDisk                  = "extra_disk"
 	KeyFailover                   = "failover"
 	KeyFirst                      = "first"
+	KeyForceDestroy               = "force_destroy"
 	KeyFS                         = "fs"
 	KeyGateway                    = "gateway"
 	KeyGwPool                     = "gw_pool"
+	KeyHealthCheck                = "health_check"
+	KeyHealthMessage              = "health_message"
+	KeyHost                       = "host"
+	KeyHosts                      = "hosts"
 	KeyID                         = "id"
+	KeyIgnoreAttachments          = "ignore_attachments_after_create"
 	KeyIngressRules               = "ingress_rules"
+	KeyInlineAdapters             = "inline_adapters"
+	KeyInlineVolumes              = "inline_volumes"
+	KeyInstance                   = "instance"
+	KeyInstances                  = "instances"
 	KeyInterface                  = "interface"
+	KeyInterval                   = "interval"
 	KeyIP                         = "ip"
 	KeyIPsecConnections           = "ipsec_connections"
 	KeyIPsecDpdAction             = "dpd_action"
@@ -67,29 +108,47 @@ const (
 	KeyIPsecP2EncryptionAlgorithm = "phase2_encryption_algorithm"
 	KeyIPsecP2IntegrityAlgorithm  = "phase2_integrity_algorithm"
 	KeyIPsecP2Lifetime            = "phase2_lifetime"
+	KeyIPsecRekeyFuzz             = "rekey_fuzz"
+	KeyIPsecRekeyMargin           = "rekey_margin"
 	KeyIPsecRekeyTime             = "rekey"
 	KeyIPsecStartAction           = "start_action"
 	KeyKawaii                     = "kawaii"
+	KeyKind                       = "kind"
+	KeyKylo                       = "kylo"
 	KeyLast                       = "last"
+	KeyLastEstablished            = "last_established"
+	KeyLocalSubnet                = "local_subnet"
+	KeyLog                        = "log"
+	KeyLogPolicy                  = "log_policy"
 	KeyMAC                        = "hwaddress"
 	KeyMaxInstances               = "max_instances"
 	KeyMaxMemory                  = "max_memory"
 	KeyMaxStorage                 = "max_storage"
 	KeyMaxVCPUs                   = "max_vcpus"
 	KeyMemory                     = "mem"
+	KeyMemoryAllocated            = "mem_allocated"
 	KeyMemoryOvercommit           = "memory_overcommit"
 	KeyMemoryPrice                = "memory_price"
+	KeyMemoryTotal                = "mem_total"
 	KeyMetadata                   = "metadata"
+	KeyMountCommand               = "mount_command"
 	KeyName                       = "name"
 	KeyNatRules                   = "nat_rules"
 	KeyNetmaskBitSize             = "netmask_bitsize"
 	KeyNetmask                    = "netmask"
 	KeyNetworkConfig              = "netcfg"
+	KeyNetworkConfigJSON          = "netcfg_json"
 	KeyNfs                        = "nfs"
+	KeyNfs3Endpoint               = "nfs3_endpoint"
+	KeyNfs4Endpoint               = "nfs4_endpoint"
 	KeyNotifications              = "notifications"
 	KeyNotify                     = "notify"
 	KeyOS                         = "os"
+	KeyOtp                        = "otp"
+	KeyOtpQRCode                  = "otp_qr_code"
+	KeyOtpSecret                  = "otp_secret"
 	KeyOwner                      = "owner"
+	KeyPackets                    = "packets"
 	KeyPolicy                     = "policy"
 	KeyPool                       = "pool"
 	KeyPort                       = "port"
@@ -99,13 +158,22 @@ const (
 	KeyPrivateIP                  = "private_ip"
 	KeyPrivateIPs                 = "private_ips"
 	KeyPrivate                    = "private"
+	KeyPriority                   = "priority"
 	KeyPrivateSubnets             = "private_subnets"
 	KeyProject                    = "project"
+	KeyProjectRoles               = "project_roles"
+	KeyProjects                   = "projects"
 	KeyProtocol                   = "protocol"
 	KeyProtocols                  = "protocols"
 	KeyPublicIP                   = "public_ip"
 	KeyPublicIPs                  = "public_ips"
 	KeyPublic                     = "public"
+	KeyRange                      = "range"
+	KeyRateLimit                  = "rate_limit"
+	KeyReady                      = "ready"
+	KeyRecordIDs                  = "record_ids"
+	KeyRecords                    = "records"
+	KeyRegenerateToken            = "regenerate_token"
 	KeyRegion                     = "region"
 	KeyRegions                    = "regions"
 	KeyRemotePeer                 = "remote_peer"
@@ -115,9 +183,17 @@ const (
 	KeyRole                       = "role"
 	KeyRootPassword               = "root_password"
 	KeyRoutes                     = "routes"
+	KeyRulesetHash                = "ruleset_hash"
 	KeySecret                     = "secret"
 	KeySize                       = "size"
 	KeySource                     = "source"
+	KeyStateful                   = "stateful"
+	KeyStats                      = "stats"
+	KeyStatus                     = "status"
+	KeyStorageAllocated           = "storage_allocated"
+	KeyStorageAvailable           = "storage_available"
+	KeyStorageTotal               = "storage_total"
+	KeyStrictProtocols            = "strict_protocols"
 	KeySubnetSize                 = "subnet_size"
 	KeySubnet                     = "subnet"
 	KeyTags                       = "tags"
@@ -125,10 +201,16 @@ const (
 	KeyTemplate                   = "template"
 	KeyTimeouts                   = "timeouts"
 	KeyToken                      = "token"
+	KeyTTL                        = "ttl"
 	KeyType                       = "type"
 	KeyURI                        = "uri"
 	KeyUsers                      = "users"
+	KeyValid                      = "valid"
+	KeyValidateRules              = "validate_rules"
+	KeyValues                     = "values"
 	KeyVCPUs                      = "vcpus"
+	KeyVCPUsAllocated             = "vcpus_allocated"
+	KeyVCPUsTotal                 = "vcpus_total"
 	KeyVLAN                       = "vlan"
 	KeyVNet                       = "vnet"
 	KeyVolumes                    = "volumes"
@@ -142,6 +224,21 @@ const (
 	HelperGbToBytes = 1073741824
 )
 
+const (
+	ImportNamePrefix    = "name:"
+	ImportIdSeparator   = "/"
+	ImportIdPlaceholder = "id"
+)
+
+// attributes whose values must never be logged
+var sensitiveKeys = []string{
+	KeyOtpQRCode,
+	KeyOtpSecret,
+	KeyPreSharedKey,
+	KeySecret,
+	KeyToken,
+}
+
 const (
 	DefaultCreateTimeout = 30 * time.Minute // large enough for template upload
 	DefaultDeleteTimeout = 5 * time.Minute
@@ -149,12 +246,44 @@ const (
 	DefaultUpdateTimeout = 5 * time.Minute
 )
 
+const (
+	ProgressLogInterval  = 30 * time.Second
+	ProgressPollInterval = 5 * time.Second
+	ProgressStateRunning = "Running"
+)
+
+const (
+	DefaultSetterRetries    = 5
+	DefaultSetterRetryDelay = 2 * time.Second
+)
+
+const (
+	ConsistencyRetries    = 5
+	ConsistencyRetryDelay = 1 * time.Second
+)
+
 const (
 	ErrorGeneric              = "Kowabunga Error"
+	ErrorDefaultNotApplied    = "Default reference was not applied"
+	ErrorUnconfiguredProvider = "Kowabunga provider is not configured yet"
 	ErrorUnconfiguredResource = "Unexpected Resource Configure Type"
 	ErrorExpectedProviderData = "Expected *KowabungaProviderData, got: %T."
+	ErrorKyloProtocols        = "Kylo enabled NFS protocols differ from requested ones"
+	ErrorImportByName         = "Unable to import resource by name"
+	ErrorImportComposite      = "Unexpected import identifier"
+	ErrorInvalidDnsRecord     = "Invalid DNS record"
+	ErrorInvalidFirewallRule  = "Invalid firewall rule"
+	ErrorInvalidNatRule       = "Invalid NAT rule"
+	ErrorInvalidRekeyMargin   = "Invalid IPsec rekey margin"
+	ErrorInvalidTimeout       = "Invalid timeout"
+	ErrorLastSuperAdmin       = "Refusing to revoke role from the last super admin user"
+	ErrorNfsDefaultForced     = "NFS storage can't be unset as region's default"
+	ErrorUnknownAgent         = "Unknown remote agent"
+	ErrorUnknownInstance      = "Unknown virtual machine instance"
 	ErrorUnknownKaktus        = "Unknown kaktus node"
 	ErrorUnknownKawaii        = "Unknown kawaii instance"
+	ErrorUnknownKiwi          = "Unknown kiwi network gateway"
+	ErrorUnknownKylo          = "Unknown kylo storage"
 	ErrorUnknownNfs           = "Unknown NFS storage"
 	ErrorUnknownProject       = "Unknown project"
 	ErrorUnknownRegion        = "Unknown region"
@@ -162,13 +291,26 @@ const (
 	ErrorUnknownSubnet        = "Unknown subnet"
 	ErrorUnknownVNet          = "Unknown virtual network"
 	ErrorUnknownTemplate      = "Unknown volume template"
+	ErrorUnknownUser          = "Unknown user"
 	ErrorUnknownZone          = "Unknown zone"
+	ErrorUnsupportedKind      = "Unsupported object kind"
+	ErrorVolumeAttached       = "Volume is attached to an instance"
+)
+
+const (
+	WarningFirewallConflict = "Conflicting firewall rules"
+	WarningNatUnreachable   = "Unreachable NAT rule destination"
 )
 
 const (
 	ResourceIdDescription   = "Resource object internal identifier"
 	ResourceNameDescription = "Resource name"
 	ResourceDescDescription = "Resource extended description"
+	ResourceTimeoutFormat   = "%s operation timeout, defaults to provider `%s` setting"
+)
+
+const (
+	ResourceDefaultValueDesc = ""
 )
 
 type ResourceBaseModel struct {
@@ -183,20 +325,28 @@ func errorUnconfiguredResource(req resource.ConfigureRequest, resp *resource.Con
 	)
 }
 
-func errorCreateGeneric(resp *resource.CreateResponse, err error) {
-	resp.Diagnostics.AddError(ErrorGeneric, err.Error())
+// builds diagnostic summary, e.g. Kowabunga Error creating kompute "web-01"
+func errorSummary(action string, kind string, name string) string {
+	if name == "" {
+		return fmt.Sprintf("%s %s %s", ErrorGeneric, action, kind)
+	}
+	return fmt.Sprintf("%s %s %s %q", ErrorGeneric, action, kind, name)
+}
+
+func errorCreateGeneric(resp *resource.CreateResponse, err error, kind string, name string) {
+	resp.Diagnostics.AddError(apiErrorDiagnostic(errorSummary("creating", kind, name), err))
 }
 
-func errorReadGeneric(resp *resource.ReadResponse, err error) {
-	resp.Diagnostics.AddError(ErrorGeneric, err.Error())
+func errorReadGeneric(resp *resource.ReadResponse, err error, kind string, name string) {
+	resp.Diagnostics.AddError(apiErrorDiagnostic(errorSummary("reading", kind, name), err))
 }
 
-func errorUpdateGeneric(resp *resource.UpdateResponse, err error) {
-	resp.Diagnostics.AddError(ErrorGeneric, err.Error())
+func errorUpdateGeneric(resp *resource.UpdateResponse, err error, kind string, name string) {
+	resp.Diagnostics.AddError(apiErrorDiagnostic(errorSummary("updating", kind, name), err))
 }
 
-func errorDeleteGeneric(resp *resource.DeleteResponse, err error) {
-	resp.Diagnostics.AddError(ErrorGeneric, err.Error())
+func errorDeleteGeneric(resp *resource.DeleteResponse, err error, kind string, name string) {
+	resp.Diagnostics.AddError(apiErrorDiagnostic(errorSummary("deleting", kind, name), err))
 }
 
 func resourceAttributes(ctx *context.Context) map[string]schema.Attribute {
@@ -224,7 +374,7 @@ func resourceAttributesWithoutName(ctx *context.Context) map[string]schema.Attri
 			MarkdownDescription: ResourceDescDescription,
 			Optional:            true,
 			Computed:            true,
-			Default:             stringdefault.StaticString(""),
+			Default:             stringdefault.StaticString(ResourceDefaultValueDesc),
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
@@ -234,14 +384,96 @@ func resourceAttributesWithoutName(ctx *context.Context) map[string]schema.Attri
 			Read:              true,
 			Update:            true,
 			Delete:            true,
-			CreateDescription: DefaultCreateTimeout.String(),
-			ReadDescription:   DefaultReadTimeout.String(),
-			UpdateDescription: DefaultUpdateTimeout.String(),
-			DeleteDescription: DefaultDeleteTimeout.String(),
+			CreateDescription: fmt.Sprintf(ResourceTimeoutFormat, "Create", KeyDefaultCreateTimeout),
+			ReadDescription:   fmt.Sprintf(ResourceTimeoutFormat, "Read", KeyDefaultReadTimeout),
+			UpdateDescription: fmt.Sprintf(ResourceTimeoutFormat, "Update", KeyDefaultUpdateTimeout),
+			DeleteDescription: fmt.Sprintf(ResourceTimeoutFormat, "Delete", KeyDefaultDeleteTimeout),
 		}),
 	}
 }
 
+// optional cost-allocation/inventory tags and metadata
+func resourceAttributesTags(kind string) map[string]schema.Attribute {
+	emptyList, _ := types.ListValue(types.StringType, []attr.Value{})
+	emptyMap, _ := types.MapValue(types.StringType, map[string]attr.Value{})
+	return map[string]schema.Attribute{
+		KeyTags: schema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("List of tags associated with the %s", kind),
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             listdefault.StaticValue(emptyList),
+		},
+		KeyMetadata: schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("List of metadatas key/value associated with the %s", kind),
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             mapdefault.StaticValue(emptyMap),
+		},
+	}
+}
+
+// converts tags from Terraform model to Kowabunga API model
+func tagsResourceToModel(list types.List) []string {
+	tags := []string{}
+	list.ElementsAs(context.TODO(), &tags, false)
+	return tags
+}
+
+// converts metadatas from Terraform model to Kowabunga API model
+func metadatasResourceToModel(metadata types.Map) []sdk.Metadata {
+	metas := map[string]string{}
+	metadata.ElementsAs(context.TODO(), &metas, false)
+	metadatas := []sdk.Metadata{}
+	for k, v := range metas {
+		m := sdk.Metadata{
+			Key:   k,
+			Value: v,
+		}
+		metadatas = append(metadatas, m)
+	}
+	return metadatas
+}
+
+// converts description from Kowabunga API model to Terraform model, unset being empty (schema default)
+func descModelToResource(desc *string) types.String {
+	if desc == nil {
+		return types.StringValue(ResourceDefaultValueDesc)
+	}
+	return types.StringPointerValue(desc)
+}
+
+// converts tags from Kowabunga API model to Terraform model
+func tagsModelToResource(tags []string) types.List {
+	list := []attr.Value{}
+	for _, t := range tags {
+		list = append(list, types.StringValue(t))
+	}
+	return types.ListValueMust(types.StringType, list)
+}
+
+// converts metadatas from Kowabunga API model to Terraform model
+func metadatasModelToResource(metadatas []sdk.Metadata) types.Map {
+	metas := map[string]attr.Value{}
+	for _, m := range metadatas {
+		metas[m.Key] = types.StringValue(m.Value)
+	}
+	return types.MapValueMust(types.StringType, metas)
+}
+
+// creation-time email notification flag, defaulting to provider-level setting
+func resourceAttributeNotify(kind string) schema.Attribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Whether Kowabunga should send email notifications upon %s creation (defaults to provider's `default_notify` value)", kind),
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
 func resourceMetadata(req resource.MetadataRequest, resp *resource.MetadataResponse, name string) {
 	resp.TypeName = req.ProviderTypeName + "_" + name
 }
@@ -250,6 +482,52 @@ func resourceImportState(ctx context.Context, req resource.ImportStateRequest, r
 	resource.ImportStatePassthroughID(ctx, path.Root(KeyID), req, resp)
 }
 
+// imports resource from either its ID or "<parent1>/<parent2>/.../<id>" composite ID, restoring parents references
+func resourceImportStateComposite(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, parents ...string) {
+	if !strings.Contains(req.ID, ImportIdSeparator) {
+		resourceImportState(ctx, req, resp)
+		return
+	}
+
+	ids := strings.Split(req.ID, ImportIdSeparator)
+	if len(ids) != len(parents)+1 || slices.Contains(ids, "") {
+		format := strings.Join(append(slices.Clone(parents), ImportIdPlaceholder), ImportIdSeparator)
+		resp.Diagnostics.AddError(ErrorImportComposite, fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, req.ID))
+		return
+	}
+
+	for i, parent := range parents {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parent), ids[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(KeyID), ids[len(parents)])...)
+}
+
+// imports resource from either its ID or "name:<value>" (resp. "name:<parent>/<value>" if parent key is set)
+func resourceImportStateByName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, data *KowabungaProviderData, parent string, getID func(context.Context, *KowabungaProviderData, string) (string, error)) {
+	if !strings.HasPrefix(req.ID, ImportNamePrefix) {
+		resourceImportState(ctx, req, resp)
+		return
+	}
+
+	name := strings.TrimPrefix(req.ID, ImportNamePrefix)
+	if parent != "" {
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parent), name[:i])...)
+			name = name[i+1:]
+		}
+	}
+
+	data.Mutex.Lock()
+	defer data.Mutex.Unlock()
+
+	id, err := getID(ctx, data, name)
+	if err != nil {
+		resp.Diagnostics.AddError(ErrorImportByName, fmt.Sprintf("%s: %s", err.Error(), name))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(KeyID), id)...)
+}
+
 func resourceConfigure(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *KowabungaProviderData {
 	if req.ProviderData == nil {
 		return nil
@@ -264,6 +542,161 @@ func resourceConfigure(req resource.ConfigureRequest, resp *resource.ConfigureRe
 	return kd
 }
 
+// resolves the effective notification flag, falling back to provider-level default when unset
+func resourceNotify(data *KowabungaProviderData, notify types.Bool) types.Bool {
+	if notify.IsNull() || notify.IsUnknown() {
+		return types.BoolValue(data.Notify)
+	}
+	return notify
+}
+
+// adopts an already existing uniquely-named object upon creation conflict, when allowed at provider-level
+func resourceAdoptExisting(ctx context.Context, data *KowabungaProviderData, err error, kind string, name string, getID func(context.Context, *KowabungaProviderData, string) (string, error)) (string, bool) {
+	if err == nil || !data.AdoptExisting || !apiErrorIsConflict(err) {
+		return "", false
+	}
+	id, err := getID(ctx, data, name)
+	if err != nil {
+		return "", false
+	}
+	tflog.Warn(ctx, fmt.Sprintf("%s %s already exists, adopting it into state (%s)", kind, name, id))
+	return id, true
+}
+
+// masks sensitive attributes values out of any subsequent log message and field
+func resourceMaskSensitive(ctx context.Context, values ...types.String) context.Context {
+	secrets := []string{}
+	for _, v := range values {
+		if !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
+			secrets = append(secrets, v.ValueString())
+		}
+	}
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, sensitiveKeys...)
+	if len(secrets) == 0 {
+		return ctx
+	}
+	ctx = tflog.MaskMessageStrings(ctx, secrets...)
+	return tflog.MaskAllFieldValuesStrings(ctx, secrets...)
+}
+
+// periodically logs a long-running operation progress, until returned stop function is called
+func resourceProgressStart(ctx context.Context, action string, kind string, name string) func() {
+	done := make(chan struct{})
+	start := time.Now()
+	go func() {
+		ticker := time.NewTicker(ProgressLogInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				tflog.Info(ctx, fmt.Sprintf("%s %s %s: still in progress", action, kind, name), map[string]any{
+					"elapsed": time.Since(start).Round(time.Second).String(),
+				})
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// polls resource state until expected one is reached, logging each transition (best effort, never fails)
+func resourceWaitState(ctx context.Context, kind string, name string, expected string, read func() (string, error)) {
+	previous := ""
+	for {
+		state, err := read()
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("%s %s: unable to read state", kind, name), map[string]any{
+				"error": err.Error(),
+			})
+			return
+		}
+		if state != previous {
+			tflog.Info(ctx, fmt.Sprintf("%s %s: %s", kind, name, state))
+			previous = state
+		}
+		if state == expected {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			tflog.Warn(ctx, fmt.Sprintf("%s %s: still %s, expected %s", kind, name, state, expected))
+			return
+		case <-time.After(ProgressPollInterval):
+		}
+	}
+}
+
+// re-reads a freshly created object until its backend-computed fields are populated, with exponential backoff (best effort, never fails)
+func resourceWaitConsistent[T any](ctx context.Context, kind string, name string, obj *T, read func() (*T, error), populated func(*T) bool) *T {
+	delay := ConsistencyRetryDelay
+	for attempt := 1; obj != nil && !populated(obj); attempt++ {
+		if attempt > ConsistencyRetries {
+			tflog.Warn(ctx, fmt.Sprintf("%s %s: computed fields still not populated", kind, name))
+			return obj
+		}
+
+		select {
+		case <-ctx.Done():
+			return obj
+		case <-time.After(delay):
+		}
+		delay *= 2
+
+		latest, err := read()
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("%s %s: unable to read back", kind, name), map[string]any{
+				"error": err.Error(),
+			})
+			return obj
+		}
+		if latest == nil {
+			tflog.Warn(ctx, fmt.Sprintf("%s %s: unable to read back, empty response", kind, name))
+			return obj
+		}
+		obj = latest
+	}
+	return obj
+}
+
+// sets parent's default reference, retrying on API conflict (concurrent setters race) until read back confirms it
+func setDefaultWithRetry(ctx context.Context, set func() (*http.Response, error), confirm func() (bool, error)) error {
+	for attempt := 1; ; attempt++ {
+		_, err := set()
+		if err != nil && !apiErrorIsConflict(err) {
+			return err
+		}
+
+		if err == nil {
+			applied, err := confirm()
+			if err != nil {
+				return err
+			}
+			if applied {
+				return nil
+			}
+			err = fmt.Errorf("%s", ErrorDefaultNotApplied)
+		}
+
+		if attempt >= DefaultSetterRetries {
+			return err
+		}
+
+		tflog.Debug(ctx, "default reference setter conflict, retrying", map[string]any{
+			"attempt": attempt,
+			"error":   err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * DefaultSetterRetryDelay):
+		}
+	}
+}
+
 func getRegionID(ctx context.Context, data *KowabungaProviderData, id string) (string, error) {
 	// let's suppose param is a proper region ID
 	region, _, err := data.K.RegionAPI.ReadRegion(ctx, id).Execute()
@@ -450,3 +883,179 @@ func getKawaiiID(ctx context.Context, data *KowabungaProviderData, id string) (s
 	}
 	return "", fmt.Errorf("%s", ErrorUnknownKawaii)
 }
+
+func getKyloID(ctx context.Context, data *KowabungaProviderData, id string) (string, error) {
+	// let's suppose param is a proper Kylo ID
+	kylo, _, err := data.K.KyloAPI.ReadKylo(ctx, id).Execute()
+	if err == nil {
+		return *kylo.Id, nil
+	}
+
+	// fall back, it may be a Kylo name then, finds its associated ID
+	kylos, _, err := data.K.KyloAPI.ListKylos(ctx).Execute()
+	if err == nil {
+		for _, k := range kylos {
+			ky, _, err := data.K.KyloAPI.ReadKylo(ctx, k).Execute()
+			if err == nil && ky.Name == id {
+				return *ky.Id, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%s", ErrorUnknownKylo)
+}
+
+func getInstanceID(ctx context.Context, data *KowabungaProviderData, id string) (string, error) {
+	// let's suppose param is a proper instance ID
+	instance, _, err := data.K.InstanceAPI.ReadInstance(ctx, id).Execute()
+	if err == nil {
+		return *instance.Id, nil
+	}
+
+	// fall back, it may be an instance name then, finds its associated ID
+	instances, _, err := data.K.InstanceAPI.ListInstances(ctx).Execute()
+	if err == nil {
+		for _, in := range instances {
+			i, _, err := data.K.InstanceAPI.ReadInstance(ctx, in).Execute()
+			if err == nil && i.Name == id {
+				return *i.Id, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%s", ErrorUnknownInstance)
+}
+
+func getUserID(ctx context.Context, data *KowabungaProviderData, id string) (string, error) {
+	// let's suppose param is a proper user ID
+	user, _, err := data.K.UserAPI.ReadUser(ctx, id).Execute()
+	if err == nil {
+		return *user.Id, nil
+	}
+
+	// fall back, it may be a user email then, finds its associated ID
+	users, _, err := data.K.UserAPI.ListUsers(ctx).Execute()
+	if err == nil {
+		for _, un := range users {
+			u, _, err := data.K.UserAPI.ReadUser(ctx, un).Execute()
+			if err == nil && u.Email == id {
+				return *u.Id, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%s", ErrorUnknownUser)
+}
+
+func getAgentID(ctx context.Context, data *KowabungaProviderData, id string) (string, error) {
+	// let's suppose param is a proper agent ID
+	agent, _, err := data.K.AgentAPI.ReadAgent(ctx, id).Execute()
+	if err == nil {
+		return *agent.Id, nil
+	}
+
+	// fall back, it may be an agent name then, finds its associated ID
+	agents, _, err := data.K.AgentAPI.ListAgents(ctx).Execute()
+	if err == nil {
+		for _, an := range agents {
+			a, _, err := data.K.AgentAPI.ReadAgent(ctx, an).Execute()
+			if err == nil && a.Name == id {
+				return *a.Id, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%s", ErrorUnknownAgent)
+}
+
+// resolves declared agents (name or ID) into IDs
+func getAgentIDs(ctx context.Context, data *KowabungaProviderData, list types.List) (map[string]string, error) {
+	agents := map[string]string{}
+
+	declared := []string{}
+	list.ElementsAs(ctx, &declared, false)
+	for _, agent := range declared {
+		agentId, err := getAgentID(ctx, data, agent)
+		if err != nil {
+			return agents, fmt.Errorf("%s: %s", err.Error(), agent)
+		}
+		agents[agent] = agentId
+	}
+
+	return agents, nil
+}
+
+// converts declared agents into Kowabunga API agent IDs
+func agentsResourceToModel(ctx *context.Context, list types.List, agents map[string]string) []string {
+	declared := []string{}
+	list.ElementsAs(*ctx, &declared, false)
+	ids := []string{}
+	for _, a := range declared {
+		if id, ok := agents[a]; ok {
+			ids = append(ids, id)
+		} else {
+			ids = append(ids, a)
+		}
+	}
+	return ids
+}
+
+// converts Kowabunga API agent IDs into agents list, keeping declared spelling
+func agentsModelToResource(ids []string, agents map[string]string) types.List {
+	names := map[string]string{}
+	for declared, id := range agents {
+		names[id] = declared
+	}
+	list := []attr.Value{}
+	for _, id := range ids {
+		if declared, ok := names[id]; ok {
+			list = append(list, types.StringValue(declared))
+		} else {
+			list = append(list, types.StringValue(id))
+		}
+	}
+	l, _ := types.ListValue(types.StringType, list)
+	return l
+}
+
+func getKaktusID(ctx context.Context, data *KowabungaProviderData, id string) (string, error) {
+	// let's suppose param is a proper kaktus ID
+	kaktus, _, err := data.K.KaktusAPI.ReadKaktus(ctx, id).Execute()
+	if err == nil {
+		return *kaktus.Id, nil
+	}
+
+	// fall back, it may be a kaktus name then, finds its associated ID
+	nodes, _, err := data.K.KaktusAPI.ListKaktuses(ctx).Execute()
+	if err == nil {
+		for _, kn := range nodes {
+			k, _, err := data.K.KaktusAPI.ReadKaktus(ctx, kn).Execute()
+			if err == nil && k.Name == id {
+				return *k.Id, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%s", ErrorUnknownKaktus)
+}
+
+func getKiwiID(ctx context.Context, data *KowabungaProviderData, id string) (string, error) {
+	// let's suppose param is a proper kiwi ID
+	kiwi, _, err := data.K.KiwiAPI.ReadKiwi(ctx, id).Execute()
+	if err == nil {
+		return *kiwi.Id, nil
+	}
+
+	// fall back, it may be a kiwi name then, finds its associated ID
+	gateways, _, err := data.K.KiwiAPI.ListKiwis(ctx).Execute()
+	if err == nil {
+		for _, kn := range gateways {
+			k, _, err := data.K.KiwiAPI.ReadKiwi(ctx, kn).Execute()
+			if err == nil && k.Name == id {
+				return *k.Id, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%s", ErrorUnknownKiwi)
+}
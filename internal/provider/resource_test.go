@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDescModelToResource(t *testing.T) {
+	desc := "my description"
+	empty := ""
+
+	tests := []struct {
+		name string
+		desc *string
+		want types.String
+	}{
+		{name: "unset", desc: nil, want: types.StringValue("")},
+		{name: "empty", desc: &empty, want: types.StringValue("")},
+		{name: "set", desc: &desc, want: types.StringValue(desc)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := descModelToResource(tt.desc); !got.Equal(tt.want) {
+				t.Errorf("descModelToResource() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceWaitConsistentEmptyRead(t *testing.T) {
+	obj := &struct{ IP string }{}
+	read := func() (*struct{ IP string }, error) {
+		return nil, nil
+	}
+	populated := func(o *struct{ IP string }) bool {
+		return o.IP != ""
+	}
+
+	if got := resourceWaitConsistent(context.Background(), "kompute", "web-01", obj, read, populated); got != obj {
+		t.Errorf("resourceWaitConsistent() = %v, want last read object %v", got, obj)
+	}
+}
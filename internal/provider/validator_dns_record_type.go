@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	ValidatorDnsRecordTypeDescription    = "DNS record type only supports the following : "
+	ValidatorDnsRecordTypeErrUnsupported = "Unsupported DNS record type"
+)
+
+const (
+	DnsRecordTypeA     = "A"
+	DnsRecordTypeAAAA  = "AAAA"
+	DnsRecordTypeCNAME = "CNAME"
+	DnsRecordTypeTXT   = "TXT"
+	DnsRecordTypeMX    = "MX"
+)
+
+var dnsRecordSupportedTypes = []string{
+	DnsRecordTypeA,
+	DnsRecordTypeAAAA,
+	DnsRecordTypeCNAME,
+	DnsRecordTypeTXT,
+	DnsRecordTypeMX,
+}
+
+type stringDnsRecordTypeValidator struct{}
+
+func (v stringDnsRecordTypeValidator) Description(ctx context.Context) string {
+	return ValidatorDnsRecordTypeDescription + strings.Join(dnsRecordSupportedTypes, ", ")
+}
+
+func (v stringDnsRecordTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringDnsRecordTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if !slices.Contains(dnsRecordSupportedTypes, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			ValidatorDnsRecordTypeErrUnsupported,
+			fmt.Sprintf("%s. Got : %s", v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
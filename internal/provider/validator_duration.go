@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -13,6 +14,29 @@ const (
 	ValidatorDurationErrUnsupported = "Unsupported duration"
 )
 
+var durationRegexp = regexp.MustCompile(`^([0-9]+)([smhd]?)$`)
+
+var durationUnits = map[string]int64{
+	"":  1,
+	"s": 1,
+	"m": 60,
+	"h": 3600,
+	"d": 86400,
+}
+
+// converts a duration string into a number of seconds
+func durationSeconds(duration string) (int64, error) {
+	m := durationRegexp.FindStringSubmatch(duration)
+	if m == nil {
+		return 0, fmt.Errorf("%s: %s", ValidatorDurationErrUnsupported, duration)
+	}
+	value, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return value * durationUnits[m[2]], nil
+}
+
 type stringDurationValidator struct{}
 
 func (v stringDurationValidator) Description(ctx context.Context) string {
@@ -29,9 +53,7 @@ func (v stringDurationValidator) ValidateString(ctx context.Context, req validat
 		return
 	}
 
-	match := false
-	match, err := regexp.MatchString("[0-9]+['s'-'m'-'h'-'d']", req.ConfigValue.ValueString())
-	if err != nil || !match {
+	if !durationRegexp.MatchString(req.ConfigValue.ValueString()) {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			ValidatorDurationErrUnsupported,
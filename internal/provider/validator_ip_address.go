@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	ValidatorIPAddressDescription = "String must be a valid IPv4 or IPv6 address"
+	ValidatorIPAddressErrInvalid  = "Invalid IPv4 or IPv6 address"
+)
+
+type stringIPAddressValidator struct{}
+
+func (v stringIPAddressValidator) Description(ctx context.Context) string {
+	return ValidatorIPAddressDescription
+}
+
+func (v stringIPAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringIPAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	ip := req.ConfigValue.ValueString()
+	if net.ParseIP(ip) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			ValidatorIPAddressErrInvalid,
+			fmt.Sprintf("%s: %s", ValidatorIPAddressErrInvalid, ip),
+		)
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	ValidatorDpdActionDescription   = "Dead Peer Detection Action only supports the following : "
+	ValidatorStartActionDescription = "Start Action only supports the following : "
+	ValidatorActionErrUnsupported   = "Unsupported action"
+)
+
+var dpdSupportedActions = []string{
+	"restart",
+	"clear",
+	"hold",
+	"none",
+}
+
+var startSupportedActions = []string{
+	"start",
+	"add",
+	"route",
+}
+
+type stringDpdActionValidator struct{}
+
+func (v stringDpdActionValidator) Description(ctx context.Context) string {
+	return ValidatorDpdActionDescription + strings.Join(dpdSupportedActions, ", ")
+}
+
+func (v stringDpdActionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringDpdActionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if !slices.Contains(dpdSupportedActions, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			ValidatorActionErrUnsupported,
+			fmt.Sprintf("%s. Got : %s", v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+type stringStartActionValidator struct{}
+
+func (v stringStartActionValidator) Description(ctx context.Context) string {
+	return ValidatorStartActionDescription + strings.Join(startSupportedActions, ", ")
+}
+
+func (v stringStartActionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringStartActionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if !slices.Contains(startSupportedActions, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			ValidatorActionErrUnsupported,
+			fmt.Sprintf("%s. Got : %s", v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
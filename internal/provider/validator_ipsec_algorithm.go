@@ -4,22 +4,26 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 const (
-	ValidatorEncryptionAlgorithmDescription = "Encryption Algorithm only supports the following "
+	ValidatorEncryptionAlgorithmDescription = "Encryption Algorithm only supports the following : "
 	ValidatorIntegrityAlgorithmDescription  = "Integrity Algorithm only supports the following : "
 	ValidatorDHAlgorithmDescription         = "Diffie Hellman Algorithm only supports the following : "
 	ValidatorAlgorithmErrUnsupported        = "Unsupported algorithm"
 )
 
 var encryptionSupportedTypes = []string{
+	"3DES",
 	"AES128",
+	"AES192",
 	"AES256",
 	"CAMELLIA128",
+	"CAMELLIA192",
 	"CAMELLIA256",
 }
 
@@ -34,10 +38,18 @@ var diffieHellmanSupportedTypes = []int64{
 	2, 5, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
 }
 
+func diffieHellmanSupportedGroups() []string {
+	groups := []string{}
+	for _, g := range diffieHellmanSupportedTypes {
+		groups = append(groups, strconv.FormatInt(g, 10))
+	}
+	return groups
+}
+
 type diffieHellmanAlgorithmTypeValidator struct{}
 
 func (v diffieHellmanAlgorithmTypeValidator) Description(ctx context.Context) string {
-	return ValidatorIntegrityAlgorithmDescription + strings.Join(integritySupportedTypes, ", ")
+	return ValidatorDHAlgorithmDescription + strings.Join(diffieHellmanSupportedGroups(), ", ")
 }
 
 func (v diffieHellmanAlgorithmTypeValidator) MarkdownDescription(ctx context.Context) string {
@@ -54,7 +66,7 @@ func (v diffieHellmanAlgorithmTypeValidator) ValidateInt64(ctx context.Context,
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			ValidatorAlgorithmErrUnsupported,
-			fmt.Sprintf("%s: %d", ValidatorDHAlgorithmDescription, req.ConfigValue.ValueInt64()),
+			fmt.Sprintf("%s. Got : %d", v.Description(ctx), req.ConfigValue.ValueInt64()),
 		)
 		return
 	}
@@ -89,7 +101,7 @@ func (v integrityAlgorithmTypeValidator) ValidateString(ctx context.Context, req
 type encryptionAlgorithmTypeValidator struct{}
 
 func (v encryptionAlgorithmTypeValidator) Description(ctx context.Context) string {
-	return ValidatorAgentTypeDescription + strings.Join(encryptionSupportedTypes, ", ")
+	return ValidatorEncryptionAlgorithmDescription + strings.Join(encryptionSupportedTypes, ", ")
 }
 
 func (v encryptionAlgorithmTypeValidator) MarkdownDescription(ctx context.Context) string {
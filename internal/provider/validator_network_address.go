@@ -9,12 +9,21 @@ import (
 )
 
 const (
-	ValidatorNetworkAddressDescription = "String must be a valid IPv4 address or CIDR"
-	ValidatorNetworkAddressErrInvalid  = "Invalid IPv4 address or CIDR"
+	ValidatorNetworkAddressDescription = "String must be a valid IPv4 or IPv6 address or CIDR"
+	ValidatorNetworkAddressErrInvalid  = "Invalid IPv4 or IPv6 address or CIDR"
 )
 
 type stringNetworkAddressValidator struct{}
 
+// checks whether string is a valid IPv4 or IPv6 address or CIDR
+func networkAddressIsValid(ip string) bool {
+	if net.ParseIP(ip) != nil {
+		return true
+	}
+	_, _, err := net.ParseCIDR(ip)
+	return err == nil
+}
+
 func (v stringNetworkAddressValidator) Description(ctx context.Context) string {
 	return ValidatorNetworkAddressDescription
 }
@@ -30,19 +39,7 @@ func (v stringNetworkAddressValidator) ValidateString(ctx context.Context, req v
 	}
 
 	ip := req.ConfigValue.ValueString()
-
-	valid_ip := true
-	if net.ParseIP(ip) == nil {
-		valid_ip = false
-	}
-
-	valid_cidr := true
-	_, _, err := net.ParseCIDR(ip)
-	if err != nil {
-		valid_cidr = false
-	}
-
-	if !valid_ip && !valid_cidr {
+	if !networkAddressIsValid(ip) {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			ValidatorNetworkAddressErrInvalid,
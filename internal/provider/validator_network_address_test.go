@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNetworkAddressIsValid(t *testing.T) {
+	tests := []struct {
+		address string
+		valid   bool
+	}{
+		{address: "0.0.0.0/0", valid: true},
+		{address: "10.0.0.1", valid: true},
+		{address: "192.168.0.0/16", valid: true},
+		{address: "::/0", valid: true},
+		{address: "::1", valid: true},
+		{address: "2001:db8::1", valid: true},
+		{address: "2001:db8::/32", valid: true},
+		{address: "2001:db8:1234::/48", valid: true},
+		{address: "", valid: false},
+		{address: "10.0.0.256", valid: false},
+		{address: "10.0.0.0/33", valid: false},
+		{address: "2001:db8::/129", valid: false},
+		{address: "2001:db8:::1", valid: false},
+		{address: "localhost", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			if got := networkAddressIsValid(tt.address); got != tt.valid {
+				t.Errorf("networkAddressIsValid(%q) = %t, want %t", tt.address, got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestStringNetworkAddressValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "null", value: types.StringNull(), wantErr: false},
+		{name: "unknown", value: types.StringUnknown(), wantErr: false},
+		{name: "any IPv4", value: types.StringValue("0.0.0.0/0"), wantErr: false},
+		{name: "any IPv6", value: types.StringValue("::/0"), wantErr: false},
+		{name: "IPv6 prefix", value: types.StringValue("2001:db8::/32"), wantErr: false},
+		{name: "invalid IPv6 prefix", value: types.StringValue("2001:db8::/200"), wantErr: true},
+		{name: "hostname", value: types.StringValue("example.com"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root(KeySource),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+			stringNetworkAddressValidator{}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateString(%s) errors = %v, want error %t", tt.value, resp.Diagnostics.Errors(), tt.wantErr)
+			}
+		})
+	}
+}
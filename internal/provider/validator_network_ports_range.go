@@ -3,74 +3,194 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 const (
-	ValidatorNetworkPortsDescription       = "Ports format must follow the following rules : comma separated, ranges ordered with a \"-\" char. e.g : 1234, 5678-5690"
+	ValidatorNetworkPortsDescription       = "Ports format must follow the following rules : comma separated, ranges ordered with a \"-\" char, well-known service names accepted. e.g : 1234, 5678-5690, https"
 	ValidatorNetworkPortsErrInvalidPort    = "Invalid port"
 	ValidatorNetworkPortsErrInvalidRange   = "Invalid range"
 	ValidatorNetworkPortsErrTooManyEntries = "Too many entries in range"
 	ValidatorNetworkPortsErrOutsideRange   = "Port outside range (0-65535) for port"
 	ValidatorNetworkPortsErrBogusRange     = "Left hand side is superior than righ hand side"
+	ValidatorNetworkPortsErrUnknownService = "Unknown service name"
 )
 
-type stringNetworkPortRangesValidator struct{}
+// well-known service names, usable as port aliases
+var networkPortServices = map[string]string{
+	"dns":        "53",
+	"ftp":        "21",
+	"http":       "80",
+	"https":      "443",
+	"imap":       "143",
+	"imaps":      "993",
+	"ldap":       "389",
+	"ldaps":      "636",
+	"mysql":      "3306",
+	"nfs":        "2049",
+	"ntp":        "123",
+	"pop3":       "110",
+	"pop3s":      "995",
+	"postgresql": "5432",
+	"rdp":        "3389",
+	"redis":      "6379",
+	"smtp":       "25",
+	"smtps":      "465",
+	"snmp":       "161",
+	"ssh":        "22",
+	"submission": "587",
+}
 
-func (v stringNetworkPortRangesValidator) Description(ctx context.Context) string {
-	return ValidatorNetworkPortsDescription
+// expands well-known service names from a port list into numeric ports
+func networkPortsExpand(ports string) string {
+	if ports == "" {
+		return ports
+	}
+
+	portList := strings.Split(ports, ",")
+	for i, port := range portList {
+		p, ok := networkPortServices[strings.ToLower(strings.TrimSpace(port))]
+		if ok {
+			portList[i] = p
+		}
+	}
+	return strings.Join(portList, ",")
 }
 
-func (v stringNetworkPortRangesValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+// parses an expanded port list into [first, last] port ranges, skipping malformed entries and reordering reversed bounds
+func networkPortsRanges(ports string) [][2]uint64 {
+	ranges := [][2]uint64{}
+	if ports == "" {
+		return ranges
+	}
+
+	for _, port := range strings.Split(ports, ",") {
+		bounds := strings.Split(strings.TrimSpace(port), "-")
+		first, err := strconv.ParseUint(bounds[0], 10, 16)
+		if err != nil || len(bounds) > 2 {
+			continue
+		}
+		last := first
+		if len(bounds) == 2 {
+			last, err = strconv.ParseUint(bounds[1], 10, 16)
+			if err != nil {
+				continue
+			}
+		}
+		if last < first {
+			first, last = last, first
+		}
+		ranges = append(ranges, [2]uint64{first, last})
+	}
+	return ranges
 }
-func (v stringNetworkPortRangesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
 
-	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
-		return
+// checks port list format, returning failure summary and detail upon error
+func networkPortsCheck(ports string) (string, error) {
+	// empty ports are allowed for port-less protocols (e.g. ICMP)
+	if ports == "" {
+		return "", nil
 	}
 
-	portList := strings.Split(req.ConfigValue.ValueString(), ",")
+	portList := strings.Split(ports, ",")
 	for _, port := range portList {
+		// well-known service name
+		_, ok := networkPortServices[strings.ToLower(strings.TrimSpace(port))]
+		if ok {
+			continue
+		}
+		if strings.IndexFunc(port, unicode.IsLetter) >= 0 {
+			return ValidatorNetworkPortsErrUnknownService, fmt.Errorf("%s: %s", ValidatorNetworkPortsErrUnknownService, port)
+		}
+
 		portRanges := strings.Split(port, "-") //returns at least 1 entry
 		if len(portRanges) > 2 {
-			resp.Diagnostics.AddAttributeError(
-				req.Path,
-				ValidatorNetworkPortsErrTooManyEntries,
-				fmt.Sprintf("%s: %s", ValidatorNetworkPortsErrTooManyEntries, port),
-			)
-			return
+			return ValidatorNetworkPortsErrTooManyEntries, fmt.Errorf("%s: %s", ValidatorNetworkPortsErrTooManyEntries, port)
 		}
-		_, err := strconv.ParseUint(portRanges[0], 10, 16)
+		first, err := strconv.ParseUint(portRanges[0], 10, 16)
 		if err != nil {
-			resp.Diagnostics.AddAttributeError(
-				req.Path,
-				ValidatorNetworkPortsErrInvalidPort,
-				fmt.Sprintf("%s: %s", ValidatorNetworkPortsErrOutsideRange, portRanges[0]),
-			)
-			return
+			return ValidatorNetworkPortsErrInvalidPort, fmt.Errorf("%s: %s", ValidatorNetworkPortsErrOutsideRange, portRanges[0])
 		}
 		if len(portRanges) == 2 && err == nil {
-			_, err = strconv.ParseUint(portRanges[1], 10, 16)
+			last, err := strconv.ParseUint(portRanges[1], 10, 16)
 			if err != nil {
-				resp.Diagnostics.AddAttributeError(
-					req.Path,
-					ValidatorNetworkPortsErrInvalidPort,
-					fmt.Sprintf("%s: %s", ValidatorNetworkPortsErrOutsideRange, portRanges[1]),
-				)
-				return
+				return ValidatorNetworkPortsErrInvalidPort, fmt.Errorf("%s: %s", ValidatorNetworkPortsErrOutsideRange, portRanges[1])
 			}
-			if portRanges[0] > portRanges[1] {
-				resp.Diagnostics.AddAttributeError(
-					req.Path,
-					ValidatorNetworkPortsErrInvalidRange,
-					fmt.Sprintf("%s: %s ", ValidatorNetworkPortsErrBogusRange, port),
-				)
-				return
+			if first > last {
+				return ValidatorNetworkPortsErrInvalidRange, fmt.Errorf("%s: %s ", ValidatorNetworkPortsErrBogusRange, port)
 			}
 		}
 	}
+
+	return "", nil
+}
+
+// normalizes a valid port list: trimmed entries, well-known service names expanded
+func networkPortsNormalize(ports string) string {
+	if ports == "" {
+		return ports
+	}
+
+	portList := strings.Split(ports, ",")
+	for i, port := range portList {
+		portList[i] = strings.TrimSpace(port)
+	}
+	return networkPortsExpand(strings.Join(portList, ","))
+}
+
+// canonicalizes a valid port list: sorted, with overlapping and adjacent ranges merged, so that equivalent specs compare equal
+func networkPortsCanonical(ports string) string {
+	ranges := networkPortsRanges(networkPortsExpand(ports))
+	if len(ranges) == 0 {
+		return ports
+	}
+
+	slices.SortFunc(ranges, func(a, b [2]uint64) int {
+		return int(a[0]) - int(b[0])
+	})
+	merged := [][2]uint64{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] <= last[1]+1 {
+			last[1] = max(last[1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	portList := []string{}
+	for _, r := range merged {
+		if r[0] == r[1] {
+			portList = append(portList, strconv.FormatUint(r[0], 10))
+			continue
+		}
+		portList = append(portList, fmt.Sprintf("%d-%d", r[0], r[1]))
+	}
+	return strings.Join(portList, ",")
+}
+
+type stringNetworkPortRangesValidator struct{}
+
+func (v stringNetworkPortRangesValidator) Description(ctx context.Context) string {
+	return ValidatorNetworkPortsDescription
+}
+
+func (v stringNetworkPortRangesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+func (v stringNetworkPortRangesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	summary, err := networkPortsCheck(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, summary, err.Error())
+	}
 }
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestNetworkPortsCanonical(t *testing.T) {
+	tests := []struct {
+		ports string
+		want  string
+	}{
+		{ports: "", want: ""},
+		{ports: "443", want: "443"},
+		{ports: "443,80", want: "80,443"},
+		{ports: "80,443", want: "80,443"},
+		{ports: "80-80", want: "80"},
+		{ports: "3000-3005,80", want: "80,3000-3005"},
+		{ports: "80-90,85-95", want: "80-95"},
+		{ports: "80-95,85-90", want: "80-95"},
+		{ports: "80,80", want: "80"},
+		{ports: "80,81", want: "80-81"},
+		{ports: "22-23,24", want: "22-24"},
+		{ports: "22,24", want: "22,24"},
+		{ports: "https,http", want: "80,443"},
+		{ports: "HTTPS,80", want: "80,443"},
+		{ports: "ssh,20-21,ftp", want: "20-22"},
+		{ports: "100-20", want: "20-100"},
+		{ports: "100-20,10", want: "10,20-100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ports, func(t *testing.T) {
+			if got := networkPortsCanonical(tt.ports); got != tt.want {
+				t.Errorf("networkPortsCanonical(%q) = %q, want %q", tt.ports, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetworkPortsCheck(t *testing.T) {
+	tests := []struct {
+		ports   string
+		summary string
+	}{
+		{ports: "", summary: ""},
+		{ports: "443", summary: ""},
+		{ports: "443,80", summary: ""},
+		{ports: "80-80", summary: ""},
+		{ports: "9-10", summary: ""},
+		{ports: "8080-10000", summary: ""},
+		{ports: "80,443,3000-3005", summary: ""},
+		{ports: "0,65535", summary: ""},
+		{ports: "https", summary: ""},
+		{ports: "SSH,https,8080", summary: ""},
+		{ports: "gopher", summary: ValidatorNetworkPortsErrUnknownService},
+		{ports: "80,http2", summary: ValidatorNetworkPortsErrUnknownService},
+		{ports: "80-90-100", summary: ValidatorNetworkPortsErrTooManyEntries},
+		{ports: "65536", summary: ValidatorNetworkPortsErrInvalidPort},
+		{ports: "80-65536", summary: ValidatorNetworkPortsErrInvalidPort},
+		{ports: "80,,443", summary: ValidatorNetworkPortsErrInvalidPort},
+		{ports: "90-80", summary: ValidatorNetworkPortsErrInvalidRange},
+		{ports: "100-20", summary: ValidatorNetworkPortsErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ports, func(t *testing.T) {
+			summary, err := networkPortsCheck(tt.ports)
+			if summary != tt.summary {
+				t.Errorf("networkPortsCheck(%q) = %q, want %q", tt.ports, summary, tt.summary)
+			}
+			if (err != nil) != (tt.summary != "") {
+				t.Errorf("networkPortsCheck(%q) error = %v, want error %t", tt.ports, err, tt.summary != "")
+			}
+		})
+	}
+}
+
+func TestNetworkPortsNormalize(t *testing.T) {
+	tests := []struct {
+		ports string
+		want  string
+	}{
+		{ports: "", want: ""},
+		{ports: "443,80", want: "443,80"},
+		{ports: " 80 , 443 ", want: "80,443"},
+		{ports: "https,22", want: "443,22"},
+		{ports: "Http, 8000-8080", want: "80,8000-8080"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ports, func(t *testing.T) {
+			if got := networkPortsNormalize(tt.ports); got != tt.want {
+				t.Errorf("networkPortsNormalize(%q) = %q, want %q", tt.ports, got, tt.want)
+			}
+		})
+	}
+}
@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	ValidatorNetworkProtocolDescription    = "Protocol must be one of 'udp, 'tcp'"
-	ValidatorNetworkProtocolErrUnsupported = "Unsupported protocol"
+	ValidatorNetworkProtocolDescription        = "Protocol must be one of 'udp, 'tcp'"
+	ValidatorNetworkIngressProtocolDescription = "Protocol must be one of 'udp, 'tcp', 'icmp'"
+	ValidatorNetworkProtocolErrUnsupported     = "Unsupported protocol"
 )
 
 var networkSupportedProtocols = []string{
@@ -19,6 +20,12 @@ var networkSupportedProtocols = []string{
 	"udp",
 }
 
+var networkIngressSupportedProtocols = []string{
+	"tcp",
+	"udp",
+	"icmp",
+}
+
 type stringNetworkProtocolValidator struct{}
 
 func (v stringNetworkProtocolValidator) Description(ctx context.Context) string {
@@ -44,3 +51,29 @@ func (v stringNetworkProtocolValidator) ValidateString(ctx context.Context, req
 		)
 	}
 }
+
+type stringNetworkIngressProtocolValidator struct{}
+
+func (v stringNetworkIngressProtocolValidator) Description(ctx context.Context) string {
+	return ValidatorNetworkIngressProtocolDescription
+}
+
+func (v stringNetworkIngressProtocolValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringNetworkIngressProtocolValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	protocol := req.ConfigValue.ValueString()
+	if !slices.Contains(networkIngressSupportedProtocols, strings.ToLower(protocol)) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			ValidatorNetworkProtocolErrUnsupported,
+			fmt.Sprintf("%s: %s", ValidatorNetworkProtocolErrUnsupported, protocol),
+		)
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	ValidatorRateLimitDescription = "Rate limit must be a number of packets per 'second', 'minute', 'hour' or 'day' (e.g. 10/second)"
+	ValidatorRateLimitErrInvalid  = "Invalid rate limit"
+)
+
+var rateLimitRegexp = regexp.MustCompile(`^[1-9][0-9]*/(second|minute|hour|day)$`)
+
+type stringRateLimitValidator struct{}
+
+func (v stringRateLimitValidator) Description(ctx context.Context) string {
+	return ValidatorRateLimitDescription
+}
+
+func (v stringRateLimitValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringRateLimitValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	rate := req.ConfigValue.ValueString()
+	if !rateLimitRegexp.MatchString(rate) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			ValidatorRateLimitErrInvalid,
+			fmt.Sprintf("%s: %s", ValidatorRateLimitErrInvalid, rate),
+		)
+	}
+}
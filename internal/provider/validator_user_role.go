@@ -10,8 +10,10 @@ import (
 )
 
 const (
-	ValidatorUserRoleDescription    = "Kowabunga user role type must be one of the following: "
-	ValidatorUserRoleErrUnsupported = "Unsupported user role"
+	ValidatorUserRoleDescription       = "Kowabunga user role type must be one of the following: "
+	ValidatorUserRoleErrUnsupported    = "Unsupported user role"
+	ValidatorProjectRoleDescription    = "Kowabunga project role type must be one of the following: "
+	ValidatorProjectRoleErrUnsupported = "Unsupported project role"
 )
 
 var userSupportedRoles = []string{
@@ -20,6 +22,11 @@ var userSupportedRoles = []string{
 	"user",
 }
 
+var projectSupportedRoles = []string{
+	"projectAdmin",
+	"user",
+}
+
 type stringUserRoleValidator struct{}
 
 func (v stringUserRoleValidator) Description(ctx context.Context) string {
@@ -45,3 +52,29 @@ func (v stringUserRoleValidator) ValidateString(ctx context.Context, req validat
 		return
 	}
 }
+
+type stringProjectRoleValidator struct{}
+
+func (v stringProjectRoleValidator) Description(ctx context.Context) string {
+	return ValidatorProjectRoleDescription + strings.Join(projectSupportedRoles, ", ")
+}
+
+func (v stringProjectRoleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringProjectRoleValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if !slices.Contains(projectSupportedRoles, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			ValidatorProjectRoleErrUnsupported,
+			fmt.Sprintf("%s: %s", ValidatorProjectRoleErrUnsupported, req.ConfigValue.ValueString()),
+		)
+		return
+	}
+}